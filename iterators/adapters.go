@@ -0,0 +1,415 @@
+package iterators
+
+// Filter returns an iterator over the elements for which f returns true.
+// The result is a ReverseIterator if the source is one.
+func Filter[T any](it Iterator[T], f func(value T) bool) Iterator[T] {
+	filtered := filter[T]{it: it, f: f}
+	if _, ok := it.(ReverseIterator[T]); ok {
+		return &reverseFilter[T]{filtered}
+	}
+	return &filtered
+}
+
+type filter[T any] struct {
+	it Iterator[T]
+	f  func(value T) bool
+}
+
+func (f *filter[T]) Next() bool {
+	for f.it.Next() {
+		if f.f(f.it.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filter[T]) Value() T {
+	return f.it.Value()
+}
+
+type reverseFilter[T any] struct {
+	filter[T]
+}
+
+func (f *reverseFilter[T]) Prev() bool {
+	it := f.it.(ReverseIterator[T])
+	for it.Prev() {
+		if f.f(it.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Map returns an iterator over the results of f applied to every element.
+// f is called once per step. The result is a ReverseIterator if the source is one.
+func Map[T any, U any](it Iterator[T], f func(value T) U) Iterator[U] {
+	mapped := mapping[T, U]{it: it, f: f}
+	if _, ok := it.(ReverseIterator[T]); ok {
+		return &reverseMapping[T, U]{mapped}
+	}
+	return &mapped
+}
+
+type mapping[T any, U any] struct {
+	it    Iterator[T]
+	f     func(value T) U
+	value U
+}
+
+func (m *mapping[T, U]) Next() bool {
+	return m.apply(m.it.Next())
+}
+
+func (m *mapping[T, U]) Value() U {
+	return m.value
+}
+
+func (m *mapping[T, U]) apply(ok bool) bool {
+	if ok {
+		m.value = m.f(m.it.Value())
+	}
+	return ok
+}
+
+type reverseMapping[T any, U any] struct {
+	mapping[T, U]
+}
+
+func (m *reverseMapping[T, U]) Prev() bool {
+	return m.apply(m.it.(ReverseIterator[T]).Prev())
+}
+
+// Take returns an iterator over at most the first n elements.
+// The result is a ReverseIterator if the source is one.
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	taken := take[T]{it: it, n: n, index: -1}
+	if _, ok := it.(ReverseIterator[T]); ok {
+		return &reverseTake[T]{taken}
+	}
+	return &taken
+}
+
+type take[T any] struct {
+	it    Iterator[T]
+	n     int
+	index int
+	// parked is set when the limit was reached while the source still stands on the last taken element.
+	parked bool
+	done   bool
+}
+
+func (t *take[T]) Next() bool {
+	switch {
+	case t.parked || t.done:
+		return false
+	case t.index >= t.n-1:
+		t.parked = true
+		t.index++
+		return false
+	case t.it.Next():
+		t.index++
+		return true
+	}
+	t.done = true
+	t.index++
+	return false
+}
+
+func (t *take[T]) Value() T {
+	return t.it.Value()
+}
+
+type reverseTake[T any] struct {
+	take[T]
+}
+
+func (t *reverseTake[T]) Prev() bool {
+	if t.index < 0 {
+		return false
+	}
+	t.index--
+	if t.parked {
+		t.parked = false
+		return t.index >= 0
+	}
+	t.done = false
+	t.it.(ReverseIterator[T]).Prev()
+	return t.index >= 0
+}
+
+// Skip returns an iterator over all but the first n elements.
+// The result is forward-only: it is never a ReverseIterator, even if the source is one.
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	return &skip[T]{it: it, n: n}
+}
+
+type skip[T any] struct {
+	it Iterator[T]
+	n  int
+}
+
+func (s *skip[T]) Next() bool {
+	for ; s.n > 0; s.n-- {
+		if !s.it.Next() {
+			return false
+		}
+	}
+	return s.it.Next()
+}
+
+func (s *skip[T]) Value() T {
+	return s.it.Value()
+}
+
+// TakeWhile returns an iterator over the leading elements for which f returns true.
+// The result is forward-only: it is never a ReverseIterator, even if the source is one.
+func TakeWhile[T any](it Iterator[T], f func(value T) bool) Iterator[T] {
+	return &takeWhile[T]{it: it, f: f}
+}
+
+type takeWhile[T any] struct {
+	it   Iterator[T]
+	f    func(value T) bool
+	done bool
+}
+
+func (t *takeWhile[T]) Next() bool {
+	if t.done {
+		return false
+	}
+	if t.it.Next() && t.f(t.it.Value()) {
+		return true
+	}
+	t.done = true
+	return false
+}
+
+func (t *takeWhile[T]) Value() T {
+	return t.it.Value()
+}
+
+// DropWhile returns an iterator over the elements following the leading elements for which f returns true.
+// The result is forward-only: it is never a ReverseIterator, even if the source is one.
+func DropWhile[T any](it Iterator[T], f func(value T) bool) Iterator[T] {
+	return &dropWhile[T]{it: it, f: f}
+}
+
+type dropWhile[T any] struct {
+	it      Iterator[T]
+	f       func(value T) bool
+	dropped bool
+}
+
+func (d *dropWhile[T]) Next() bool {
+	if d.dropped {
+		return d.it.Next()
+	}
+	d.dropped = true
+	for d.it.Next() {
+		if !d.f(d.it.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dropWhile[T]) Value() T {
+	return d.it.Value()
+}
+
+// Zip returns an iterator over pairs of elements of both iterators that stops as soon as either of them does.
+// The result is a ReverseIterator if both sources are.
+func Zip[A any, B any](a Iterator[A], b Iterator[B]) Iterator[Pair[A, B]] {
+	zipped := zip[A, B]{a: a, b: b}
+	_, okA := a.(ReverseIterator[A])
+	_, okB := b.(ReverseIterator[B])
+	if okA && okB {
+		return &reverseZip[A, B]{zipped}
+	}
+	return &zipped
+}
+
+type zip[A any, B any] struct {
+	a Iterator[A]
+	b Iterator[B]
+	// ended is set once either source has run out, after both of them moved past the last pair.
+	ended bool
+}
+
+// Next always advances both sources so that they stay in step for Prev, even when one of them runs out first.
+func (z *zip[A, B]) Next() bool {
+	if z.ended {
+		return false
+	}
+	okA := z.a.Next()
+	okB := z.b.Next()
+	z.ended = !okA || !okB
+	return !z.ended
+}
+
+func (z *zip[A, B]) Value() Pair[A, B] {
+	return Pair[A, B]{First: z.a.Value(), Second: z.b.Value()}
+}
+
+type reverseZip[A any, B any] struct {
+	zip[A, B]
+}
+
+func (z *reverseZip[A, B]) Prev() bool {
+	z.ended = false
+	okA := z.a.(ReverseIterator[A]).Prev()
+	okB := z.b.(ReverseIterator[B]).Prev()
+	return okA && okB
+}
+
+// Chain returns an iterator over the elements of all iterators one after another.
+// The result is a ReverseIterator if all sources are.
+func Chain[T any](its ...Iterator[T]) Iterator[T] {
+	chained := chain[T]{its: its}
+	for _, it := range its {
+		if _, ok := it.(ReverseIterator[T]); !ok {
+			return &chained
+		}
+	}
+	return &reverseChain[T]{chained}
+}
+
+type chain[T any] struct {
+	its   []Iterator[T]
+	index int
+}
+
+func (c *chain[T]) Next() bool {
+	for ; c.index < len(c.its); c.index++ {
+		if c.its[c.index].Next() {
+			return true
+		}
+		if c.index == len(c.its)-1 {
+			break
+		}
+	}
+	return false
+}
+
+func (c *chain[T]) Value() T {
+	return c.its[c.index].Value()
+}
+
+type reverseChain[T any] struct {
+	chain[T]
+}
+
+func (c *reverseChain[T]) Prev() bool {
+	for ; c.index >= 0 && c.index < len(c.its); c.index-- {
+		if c.its[c.index].(ReverseIterator[T]).Prev() {
+			return true
+		}
+		if c.index == 0 {
+			break
+		}
+	}
+	return false
+}
+
+// Dedup returns an iterator that collapses runs of consecutive equal elements into a single element.
+// The result is a ReverseIterator if the source is one.
+func Dedup[T any](it Iterator[T], equal func(a, b T) bool) Iterator[T] {
+	deduped := dedup[T]{it: it, equal: equal}
+	if _, ok := it.(ReverseIterator[T]); ok {
+		return &reverseDedup[T]{deduped}
+	}
+	return &deduped
+}
+
+type dedup[T any] struct {
+	it     Iterator[T]
+	equal  func(a, b T) bool
+	value  T
+	active bool
+}
+
+func (d *dedup[T]) Next() bool {
+	return d.step(d.it.Next)
+}
+
+func (d *dedup[T]) Value() T {
+	return d.value
+}
+
+// step moves the source until it leaves the run of the current element.
+func (d *dedup[T]) step(move func() bool) bool {
+	for move() {
+		if value := d.it.Value(); !d.active || !d.equal(value, d.value) {
+			d.value, d.active = value, true
+			return true
+		}
+	}
+	d.active = false
+	return false
+}
+
+type reverseDedup[T any] struct {
+	dedup[T]
+}
+
+func (d *reverseDedup[T]) Prev() bool {
+	return d.step(d.it.(ReverseIterator[T]).Prev)
+}
+
+// Window returns an iterator over all runs of n consecutive elements, each as a new slice.
+func Window[T any](it Iterator[T], n int) Iterator[[]T] {
+	return &window[T]{it: it, n: n}
+}
+
+type window[T any] struct {
+	it     Iterator[T]
+	n      int
+	buffer []T
+}
+
+func (w *window[T]) Next() bool {
+	if w.n <= 0 {
+		return false
+	}
+	if len(w.buffer) == w.n {
+		w.buffer = append([]T(nil), w.buffer[1:]...)
+	}
+	for len(w.buffer) < w.n {
+		if !w.it.Next() {
+			w.buffer = nil
+			w.n = 0
+			return false
+		}
+		w.buffer = append(w.buffer, w.it.Value())
+	}
+	return true
+}
+
+func (w *window[T]) Value() []T {
+	return w.buffer
+}
+
+// Chunk returns an iterator over consecutive slices of n elements, the last of which may be shorter.
+func Chunk[T any](it Iterator[T], n int) Iterator[[]T] {
+	return &chunk[T]{it: it, n: n}
+}
+
+type chunk[T any] struct {
+	it     Iterator[T]
+	n      int
+	buffer []T
+}
+
+func (c *chunk[T]) Next() bool {
+	c.buffer = nil
+	for c.n > 0 && len(c.buffer) < c.n && c.it.Next() {
+		c.buffer = append(c.buffer, c.it.Value())
+	}
+	return len(c.buffer) > 0
+}
+
+func (c *chunk[T]) Value() []T {
+	return c.buffer
+}
@@ -0,0 +1,118 @@
+// Package iterators provides lazy adapters over the iterators of the ordered containers.
+//
+// Adapters pull from their source only when they are advanced, so chaining them does not allocate
+// intermediate containers. Adapters that can step backwards return a ReverseIterator when their
+// source is a ReverseIterator, which can be recovered with a type assertion.
+package iterators
+
+import (
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/set/treeset"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Iterator is a lazy sequence of values advanced by Next.
+type Iterator[T any] interface {
+	// Next moves the iterator to the next element and returns true if there was a next element.
+	Next() bool
+	// Value returns the current element.
+	Value() T
+}
+
+// ReverseIterator is an Iterator that can also move to the previous element.
+type ReverseIterator[T any] interface {
+	Iterator[T]
+	// Prev moves the iterator to the previous element and returns true if there was a previous element.
+	Prev() bool
+}
+
+// Pair holds the elements produced by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Entries adapts a map iterator into an iterator over its entries, starting from the iterator's current position.
+// The result is a ReverseIterator.
+func Entries[K any, V any](it treemap.Iterator[K, V]) Iterator[treemap.Entry[K, V]] {
+	return &entries[K, V]{it: it}
+}
+
+type entries[K any, V any] struct {
+	it treemap.Iterator[K, V]
+}
+
+func (e *entries[K, V]) Next() bool {
+	return e.it.Next()
+}
+
+func (e *entries[K, V]) Prev() bool {
+	return e.it.Prev()
+}
+
+func (e *entries[K, V]) Value() treemap.Entry[K, V] {
+	return treemap.Entry[K, V]{Key: e.it.Key(), Value: e.it.Value()}
+}
+
+// Values adapts a set iterator into an iterator over its items, starting from the iterator's current position.
+// The result is a ReverseIterator.
+func Values[V any](it treeset.Iterator[V]) Iterator[V] {
+	return &it
+}
+
+// Slice returns an iterator over the items of the slice.
+// The result is a ReverseIterator.
+func Slice[T any](items []T) Iterator[T] {
+	return &slice[T]{items: items, index: -1}
+}
+
+type slice[T any] struct {
+	items []T
+	index int
+}
+
+func (s *slice[T]) Next() bool {
+	if s.index < len(s.items) {
+		s.index++
+	}
+	return s.index < len(s.items)
+}
+
+func (s *slice[T]) Prev() bool {
+	if s.index >= 0 {
+		s.index--
+	}
+	return s.index >= 0
+}
+
+func (s *slice[T]) Value() T {
+	return s.items[s.index]
+}
+
+// ToSlice drains the iterator into a slice.
+func ToSlice[T any](it Iterator[T]) []T {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	return items
+}
+
+// ToMap drains the iterator of entries into a new map with the custom comparator.
+func ToMap[K any, V any](it Iterator[treemap.Entry[K, V]], comparator utils.Comparator[K]) *treemap.Map[K, V] {
+	m := treemap.NewWithComparator[K, V](comparator)
+	for it.Next() {
+		entry := it.Value()
+		m.Put(entry.Key, entry.Value)
+	}
+	return m
+}
+
+// ToSet drains the iterator into a new set with the custom comparator.
+func ToSet[V any](it Iterator[V], comparator utils.Comparator[V]) *treeset.Set[V] {
+	set := treeset.NewWithComparator(comparator)
+	for it.Next() {
+		set.Add(it.Value())
+	}
+	return set
+}
@@ -0,0 +1,119 @@
+package iterators
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/set/treeset"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func drainBackward[T any](it Iterator[T]) []T {
+	var items []T
+	reverse := it.(ReverseIterator[T])
+	for reverse.Prev() {
+		items = append(items, reverse.Value())
+	}
+	return items
+}
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestFilterAndMap(t *testing.T) {
+	it := Map(Filter(Slice([]int{1, 2, 3, 4, 5, 6}), isEven), func(value int) int { return value * 10 })
+	assert.Equal(t, []int{20, 40, 60}, ToSlice(it))
+	assert.Equal(t, []int{60, 40, 20}, drainBackward(it))
+}
+
+func TestTake(t *testing.T) {
+	it := Take(Slice([]int{1, 2, 3, 4, 5}), 3)
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(it))
+	assert.False(t, it.Next())
+	assert.Equal(t, []int{3, 2, 1}, drainBackward(it))
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(it))
+
+	it = Take(Slice([]int{1, 2}), 3)
+	assert.Equal(t, []int{1, 2}, ToSlice(it))
+	assert.Equal(t, []int{2, 1}, drainBackward(it))
+
+	assert.Empty(t, ToSlice(Take(Slice([]int{1, 2}), 0)))
+}
+
+func TestSkipTakeWhileDropWhile(t *testing.T) {
+	items := []int{2, 4, 5, 6, 7}
+	assert.Equal(t, []int{5, 6, 7}, ToSlice(Skip(Slice(items), 2)))
+	assert.Empty(t, ToSlice(Skip(Slice(items), 10)))
+	assert.Equal(t, []int{2, 4}, ToSlice(TakeWhile(Slice(items), isEven)))
+	assert.Equal(t, []int{5, 6, 7}, ToSlice(DropWhile(Slice(items), isEven)))
+
+	_, ok := Skip(Slice(items), 2).(ReverseIterator[int])
+	assert.False(t, ok)
+	_, ok = TakeWhile(Slice(items), isEven).(ReverseIterator[int])
+	assert.False(t, ok)
+	_, ok = DropWhile(Slice(items), isEven).(ReverseIterator[int])
+	assert.False(t, ok)
+}
+
+func TestZipAndChain(t *testing.T) {
+	zipped := Zip(Slice([]int{1, 2, 3}), Slice([]string{"a", "b"}))
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, ToSlice(zipped))
+
+	chained := Chain(Slice([]int{1, 2}), Slice([]int{}), Slice([]int{3}))
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(chained))
+	assert.Equal(t, []int{3, 2, 1}, drainBackward(chained))
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(chained))
+}
+
+func TestZipShorterFirst(t *testing.T) {
+	zipped := Zip(Slice([]int{1, 2}), Slice([]string{"x", "y", "z"}))
+	assert.Equal(t, []Pair[int, string]{{1, "x"}, {2, "y"}}, ToSlice(zipped))
+	assert.False(t, zipped.Next())
+	assert.Equal(t, []Pair[int, string]{{2, "y"}, {1, "x"}}, drainBackward(zipped))
+	assert.Equal(t, []Pair[int, string]{{1, "x"}, {2, "y"}}, ToSlice(zipped))
+}
+
+func TestZipShorterSecond(t *testing.T) {
+	zipped := Zip(Slice([]int{1, 2, 3}), Slice([]string{"x", "y"}))
+	assert.Equal(t, []Pair[int, string]{{1, "x"}, {2, "y"}}, ToSlice(zipped))
+	assert.False(t, zipped.Next())
+	assert.Equal(t, []Pair[int, string]{{2, "y"}, {1, "x"}}, drainBackward(zipped))
+	assert.Equal(t, []Pair[int, string]{{1, "x"}, {2, "y"}}, ToSlice(zipped))
+}
+
+func TestZipEmpty(t *testing.T) {
+	zipped := Zip(Slice([]int{}), Slice([]string{"x"}))
+	assert.Empty(t, ToSlice(zipped))
+	assert.Empty(t, drainBackward(zipped))
+}
+
+func TestDedup(t *testing.T) {
+	equal := func(a, b int) bool { return a == b }
+	it := Dedup(Slice([]int{1, 1, 2, 3, 3, 3, 1}), equal)
+	assert.Equal(t, []int{1, 2, 3, 1}, ToSlice(it))
+	assert.Equal(t, []int{1, 3, 2, 1}, drainBackward(it))
+}
+
+func TestWindowAndChunk(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, ToSlice(Window(Slice(items), 3)))
+	assert.Empty(t, ToSlice(Window(Slice(items), 6)))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, ToSlice(Chunk(Slice(items), 2)))
+}
+
+func TestContainers(t *testing.T) {
+	m := treemap.NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	odd := Filter(Entries(m.Iterator()), func(entry treemap.Entry[int, string]) bool { return entry.Key%2 == 1 })
+	assert.Equal(t, []int{1, 3}, ToMap(odd, utils.NumbersComparator[int]).Keys())
+
+	set := treeset.NewWithComparator(utils.NumbersComparator[int])
+	set.Add(1, 2, 3, 4)
+	even := ToSet(Filter(Values(set.Iterator()), isEven), utils.NumbersComparator[int])
+	assert.Equal(t, []int{2, 4}, even.Values())
+}
@@ -0,0 +1,191 @@
+// Package boundedmap provides a tree map that never exceeds a capacity,
+// evicting its smallest or largest keys to make room, e.g. for top-N leaderboards and sliding windows.
+package boundedmap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Policy tells which end of the map is evicted when it is over capacity.
+type Policy int
+
+const (
+	// EvictMin evicts the smallest keys, keeping the largest ones.
+	EvictMin Policy = iota
+	// EvictMax evicts the largest keys, keeping the smallest ones.
+	EvictMax
+)
+
+// Map is a tree map whose total weight never exceeds its capacity.
+// Without a weight function every entry weighs 1, so the capacity is the maximum number of entries.
+type Map[K any, V any] struct {
+	m          *treemap.Map[K, V]
+	comparator utils.Comparator[K]
+	capacity   int
+	policy     Policy
+	weight     func(key K, value V) int
+	total      int
+	onEvict    func(key K, value V)
+}
+
+// New instantiates a map holding up to capacity entries, evicting according to the policy.
+func New[K any, V any](comparator utils.Comparator[K], capacity int, policy Policy) *Map[K, V] {
+	return NewWithWeight[K, V](comparator, capacity, policy, func(K, V) int { return 1 })
+}
+
+// NewWithWeight instantiates a map whose entries weigh up to capacity in total, evicting according to the policy.
+// Weights must not be negative.
+func NewWithWeight[K any, V any](comparator utils.Comparator[K], capacity int, policy Policy, weight func(key K, value V) int) *Map[K, V] {
+	return &Map[K, V]{
+		m:          treemap.NewWithComparator[K, V](comparator),
+		comparator: comparator,
+		capacity:   capacity,
+		policy:     policy,
+		weight:     weight,
+	}
+}
+
+// OnEvict registers f to be called with every entry evicted to make room for another one.
+// It is not called for entries removed or replaced explicitly.
+func (m *Map[K, V]) OnEvict(f func(key K, value V)) {
+	m.onEvict = f
+}
+
+// Put inserts key-value pair into the map, evicting entries from the end given by the policy while it is over capacity.
+// If the new entry would be evicted itself, the map is left unchanged and false is returned.
+func (m *Map[K, V]) Put(key K, value V) bool {
+	weight := m.weight(key, value)
+	previous, replacing := m.m.Get(key)
+	excess := m.total + weight - m.capacity
+	if replacing {
+		excess -= m.weight(key, previous)
+	}
+	if excess > 0 && !m.fits(key, excess) {
+		return false
+	}
+	if previous, loaded := m.m.Swap(key, value); loaded {
+		m.total -= m.weight(key, previous)
+	}
+	m.total += weight
+	for m.total > m.capacity {
+		var victim treemap.Entry[K, V]
+		if m.policy == EvictMin {
+			victim, _ = m.m.PollFirst()
+		} else {
+			victim, _ = m.m.PollLast()
+		}
+		m.total -= m.weight(victim.Key, victim.Value)
+		if m.onEvict != nil {
+			m.onEvict(victim.Key, victim.Value)
+		}
+	}
+	return true
+}
+
+// fits returns true if evicting entries in policy order frees excess weight before reaching the key.
+func (m *Map[K, V]) fits(key K, excess int) bool {
+	it := m.m.Iterator()
+	step, beyond := it.Next, 1
+	if m.policy == EvictMax {
+		it.End()
+		step, beyond = it.Prev, -1
+	}
+	for excess > 0 && step() {
+		compare := m.comparator(it.Key(), key)
+		if compare == 0 {
+			// the entry replaced by the key does not count
+			continue
+		}
+		if compare*beyond > 0 {
+			return false
+		}
+		excess -= m.weight(it.Key(), it.Value())
+	}
+	return excess <= 0
+}
+
+// Get searches the element in the map by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	return m.m.Get(key)
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, V]) Remove(key K) {
+	if previous, found := m.m.Get(key); found {
+		m.m.Remove(key)
+		m.total -= m.weight(key, previous)
+	}
+}
+
+// Capacity returns the maximum total weight of the map.
+func (m *Map[K, V]) Capacity() int {
+	return m.capacity
+}
+
+// Weight returns the total weight of the entries of the map.
+func (m *Map[K, V]) Weight() int {
+	return m.total
+}
+
+// Empty returns true if map does not contain any elements.
+func (m *Map[K, V]) Empty() bool {
+	return m.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return m.m.Size()
+}
+
+// Keys returns all keys in-order.
+func (m *Map[K, V]) Keys() []K {
+	return m.m.Keys()
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[K, V]) Values() []V {
+	return m.m.Values()
+}
+
+// MinEntry returns the entry with the minimum key.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) MinEntry() (entry treemap.Entry[K, V], found bool) {
+	return m.m.MinEntry()
+}
+
+// MaxEntry returns the entry with the maximum key.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) MaxEntry() (entry treemap.Entry[K, V], found bool) {
+	return m.m.MaxEntry()
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The map must not be modified through the iterator.
+func (m *Map[K, V]) Iterator() treemap.Iterator[K, V] {
+	return m.m.Iterator()
+}
+
+// Each calls the given function once for each element in-order, passing that element's key and value.
+func (m *Map[K, V]) Each(f func(key K, value V)) {
+	m.m.Each(f)
+}
+
+// Clear removes all elements from the map without calling the eviction callback.
+func (m *Map[K, V]) Clear() {
+	m.m.Clear()
+	m.total = 0
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "BoundedMap\nmap["
+	m.Each(func(key K, value V) {
+		str += fmt.Sprintf("%v:%v ", key, value)
+	})
+	return strings.TrimRight(str, " ") + "]"
+}
@@ -0,0 +1,76 @@
+package boundedmap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundedMapEvictMin(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], 3, EvictMin)
+	var evicted []int
+	m.OnEvict(func(key int, _ string) {
+		evicted = append(evicted, key)
+	})
+	for _, key := range []int{5, 3, 8} {
+		assert.True(t, m.Put(key, "v"))
+	}
+	assert.True(t, m.Put(6, "v"))
+	assert.Equal(t, []int{5, 6, 8}, m.Keys())
+	assert.Equal(t, []int{3}, evicted)
+
+	// the smallest key would be evicted right away
+	assert.False(t, m.Put(1, "v"))
+	assert.Equal(t, []int{5, 6, 8}, m.Keys())
+
+	// replacing a present key never evicts
+	assert.True(t, m.Put(5, "w"))
+	value, _ := m.Get(5)
+	assert.Equal(t, "w", value)
+	assert.Equal(t, []int{3}, evicted)
+
+	m.Remove(8)
+	assert.True(t, m.Put(1, "v"))
+	assert.Equal(t, []int{1, 5, 6}, m.Keys())
+	assert.Equal(t, 3, m.Weight())
+}
+
+func TestBoundedMapEvictMax(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], 2, EvictMax)
+	assert.True(t, m.Put(5, "v"))
+	assert.True(t, m.Put(3, "v"))
+	assert.False(t, m.Put(9, "v"))
+	assert.True(t, m.Put(1, "v"))
+	assert.Equal(t, []int{1, 3}, m.Keys())
+}
+
+func TestBoundedMapWeight(t *testing.T) {
+	m := NewWithWeight[string, string](utils.StringComparator, 10, EvictMin, func(_ string, value string) int {
+		return len(value)
+	})
+	var evicted []string
+	m.OnEvict(func(key string, _ string) {
+		evicted = append(evicted, key)
+	})
+	assert.True(t, m.Put("a", "1234"))
+	assert.True(t, m.Put("b", "123"))
+	assert.True(t, m.Put("c", "123"))
+	assert.Equal(t, 10, m.Weight())
+
+	// "d" needs 5 units, so both "a" and "b" go
+	assert.True(t, m.Put("d", "12345"))
+	assert.Equal(t, []string{"c", "d"}, m.Keys())
+	assert.Equal(t, []string{"a", "b"}, evicted)
+	assert.Equal(t, 8, m.Weight())
+
+	// growing "c" would evict "c" itself, too heavy items never fit
+	assert.False(t, m.Put("c", "12345678"))
+	assert.False(t, m.Put("z", "12345678901"))
+	assert.Equal(t, 8, m.Weight())
+
+	// growing "d" evicts "c" only
+	assert.True(t, m.Put("d", "1234567890"))
+	assert.Equal(t, []string{"d"}, m.Keys())
+	assert.Equal(t, 10, m.Weight())
+}
@@ -0,0 +1,394 @@
+// Package durable provides a tree map persisted to disk through a write-ahead log.
+//
+// Every mutation is appended to the log before it is applied to the map, and a full snapshot
+// of the map periodically replaces the log. Open rebuilds the map by loading the snapshot
+// and replaying the log on top of it, truncating a torn record left by a crash at the end of the log.
+package durable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+const (
+	logName      = "wal"
+	snapshotName = "snapshot"
+)
+
+// ErrCorruptSnapshot is returned by Open when the snapshot fails its checksums.
+var ErrCorruptSnapshot = errors.New("durable: corrupt snapshot")
+
+// ErrClosed is returned by the mutations of a closed map.
+var ErrClosed = errors.New("durable: map is closed")
+
+// SyncPolicy tells when the log is flushed to stable storage.
+type SyncPolicy int
+
+const (
+	// SyncAlways syncs the log after every mutation, so a mutation is durable once it returns.
+	SyncAlways SyncPolicy = iota
+	// SyncPeriodic syncs the log on the first mutation after SyncInterval has elapsed since the last sync.
+	SyncPeriodic
+	// SyncNever leaves flushing to the operating system, or to explicit calls to Sync.
+	SyncNever
+)
+
+// Options configure how a map is encoded and persisted.
+type Options[K any, V any] struct {
+	Comparator utils.Comparator[K]
+	KeyCodec   utils.Codec[K]
+	ValueCodec utils.Codec[V]
+	// Sync is the fsync policy of the log, SyncAlways by default.
+	Sync SyncPolicy
+	// SyncInterval is the interval of the SyncPeriodic policy.
+	SyncInterval time.Duration
+	// SnapshotEvery is the number of logged mutations after which a snapshot replaces the log.
+	// Zero disables automatic snapshots.
+	SnapshotEvery int
+}
+
+// Map is a tree map whose mutations are logged to a directory on disk.
+// A mutation failing to be logged is not applied, while one failing afterwards,
+// to sync the log or take a snapshot, is applied and will be replayed.
+// It is not safe for concurrent use.
+type Map[K any, V any] struct {
+	m        *treemap.Map[K, V]
+	options  Options[K, V]
+	dir      string
+	log      *os.File
+	offset   int64 // end of the last intact record of the log
+	logged   int
+	lastSync time.Time
+	buf      []byte
+}
+
+// Open opens the map stored in the directory at path, creating the directory if needed,
+// and rebuilds it from the snapshot and the log. A damaged record at the end of the log,
+// left by a crash in the middle of a write, is truncated together with everything after it.
+func Open[K any, V any](path string, options Options[K, V]) (*Map[K, V], error) {
+	if options.Comparator == nil || options.KeyCodec == nil || options.ValueCodec == nil {
+		return nil, errors.New("durable: comparator and codecs are required")
+	}
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, fmt.Errorf("durable: %w", err)
+	}
+	m := &Map[K, V]{
+		m:        treemap.NewWithComparator[K, V](options.Comparator),
+		options:  options,
+		dir:      path,
+		lastSync: time.Now(),
+	}
+	if err := m.loadSnapshot(); err != nil {
+		return nil, err
+	}
+	log, err := os.OpenFile(filepath.Join(path, logName), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("durable: %w", err)
+	}
+	if err := m.replay(log); err != nil {
+		log.Close()
+		return nil, err
+	}
+	m.log = log
+	return m, nil
+}
+
+// Put inserts key-value pair into the map once it is logged.
+func (m *Map[K, V]) Put(key K, value V) error {
+	encodedKey, err := m.options.KeyCodec.Encode(key)
+	if err != nil {
+		return fmt.Errorf("durable: encode key: %w", err)
+	}
+	encodedValue, err := m.options.ValueCodec.Encode(value)
+	if err != nil {
+		return fmt.Errorf("durable: encode value: %w", err)
+	}
+	if err := m.append(record{op: opPut, key: encodedKey, value: encodedValue}); err != nil {
+		return err
+	}
+	m.m.Put(key, value)
+	return m.commit()
+}
+
+// Remove removes the element from the map by key once it is logged. Absent keys are not logged.
+func (m *Map[K, V]) Remove(key K) error {
+	if _, found := m.m.Get(key); !found {
+		return nil
+	}
+	encodedKey, err := m.options.KeyCodec.Encode(key)
+	if err != nil {
+		return fmt.Errorf("durable: encode key: %w", err)
+	}
+	if err := m.append(record{op: opRemove, key: encodedKey}); err != nil {
+		return err
+	}
+	m.m.Remove(key)
+	return m.commit()
+}
+
+// Clear removes all elements from the map once it is logged.
+func (m *Map[K, V]) Clear() error {
+	if err := m.append(record{op: opClear}); err != nil {
+		return err
+	}
+	m.m.Clear()
+	return m.commit()
+}
+
+// Get searches the element in the map by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	return m.m.Get(key)
+}
+
+// Empty returns true if map does not contain any elements.
+func (m *Map[K, V]) Empty() bool {
+	return m.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return m.m.Size()
+}
+
+// Keys returns all keys in-order.
+func (m *Map[K, V]) Keys() []K {
+	return m.m.Keys()
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[K, V]) Values() []V {
+	return m.m.Values()
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The map must not be modified through the iterator.
+func (m *Map[K, V]) Iterator() treemap.Iterator[K, V] {
+	return m.m.Iterator()
+}
+
+// Sync flushes the log to stable storage.
+func (m *Map[K, V]) Sync() error {
+	if m.log == nil {
+		return ErrClosed
+	}
+	if err := m.log.Sync(); err != nil {
+		return fmt.Errorf("durable: sync log: %w", err)
+	}
+	m.lastSync = time.Now()
+	return nil
+}
+
+// Snapshot writes the whole map to a new snapshot, atomically replacing the previous one, and empties the log.
+func (m *Map[K, V]) Snapshot() error {
+	if m.log == nil {
+		return ErrClosed
+	}
+	tmp := filepath.Join(m.dir, snapshotName+".tmp")
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("durable: snapshot: %w", err)
+	}
+	writer := bufio.NewWriter(file)
+	for it := m.m.Iterator(); it.Next() && err == nil; {
+		var key, value []byte
+		if key, err = m.options.KeyCodec.Encode(it.Key()); err != nil {
+			break
+		}
+		if value, err = m.options.ValueCodec.Encode(it.Value()); err != nil {
+			break
+		}
+		m.buf = appendRecord(m.buf[:0], record{op: opPut, key: key, value: value})
+		_, err = writer.Write(m.buf)
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, filepath.Join(m.dir, snapshotName))
+	}
+	if err == nil {
+		err = syncDir(m.dir)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("durable: snapshot: %w", err)
+	}
+	// replaying the old log over the new snapshot would rebuild the same map,
+	// so a crash before the log is emptied is harmless
+	if err := m.log.Truncate(0); err != nil {
+		return fmt.Errorf("durable: truncate log: %w", err)
+	}
+	if _, err := m.log.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("durable: truncate log: %w", err)
+	}
+	m.offset, m.logged = 0, 0
+	return m.Sync()
+}
+
+// Close syncs and closes the log. The map must not be modified afterwards.
+func (m *Map[K, V]) Close() error {
+	if m.log == nil {
+		return ErrClosed
+	}
+	err := m.log.Sync()
+	if closeErr := m.log.Close(); err == nil {
+		err = closeErr
+	}
+	m.log = nil
+	if err != nil {
+		return fmt.Errorf("durable: close log: %w", err)
+	}
+	return nil
+}
+
+// append writes the record to the log. A partially written record is cut off again,
+// so that it does not hide the records written after it from replay.
+func (m *Map[K, V]) append(r record) error {
+	if m.log == nil {
+		return ErrClosed
+	}
+	m.buf = appendRecord(m.buf[:0], r)
+	if _, err := m.log.Write(m.buf); err != nil {
+		if m.log.Truncate(m.offset) == nil {
+			m.log.Seek(m.offset, io.SeekStart)
+		}
+		return fmt.Errorf("durable: write log: %w", err)
+	}
+	m.offset += int64(len(m.buf))
+	m.logged++
+	return nil
+}
+
+// commit syncs the log according to the policy once a mutation was applied,
+// and takes a snapshot once enough mutations were logged.
+func (m *Map[K, V]) commit() error {
+	switch m.options.Sync {
+	case SyncAlways:
+		if err := m.Sync(); err != nil {
+			return err
+		}
+	case SyncPeriodic:
+		if time.Since(m.lastSync) >= m.options.SyncInterval {
+			if err := m.Sync(); err != nil {
+				return err
+			}
+		}
+	}
+	if m.options.SnapshotEvery > 0 && m.logged >= m.options.SnapshotEvery {
+		return m.Snapshot()
+	}
+	return nil
+}
+
+// loadSnapshot fills the map from the snapshot, if there is one.
+func (m *Map[K, V]) loadSnapshot() error {
+	file, err := os.Open(filepath.Join(m.dir, snapshotName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("durable: %w", err)
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	for {
+		r, _, err := readRecord(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err == errDamaged || err == nil && r.op != opPut {
+			return ErrCorruptSnapshot
+		}
+		if err != nil {
+			return fmt.Errorf("durable: read snapshot: %w", err)
+		}
+		if err := m.apply(r); err != nil {
+			return err
+		}
+	}
+}
+
+// replay applies the records of the log and truncates it after the last intact one.
+func (m *Map[K, V]) replay(log *os.File) error {
+	reader := bufio.NewReader(log)
+	var offset int64
+	for {
+		r, size, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err == errDamaged {
+			if err := log.Truncate(offset); err != nil {
+				return fmt.Errorf("durable: truncate log: %w", err)
+			}
+			if err := log.Sync(); err != nil {
+				return fmt.Errorf("durable: sync log: %w", err)
+			}
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("durable: read log: %w", err)
+		}
+		if err := m.apply(r); err != nil {
+			return err
+		}
+		offset += int64(size)
+		m.logged++
+	}
+	if _, err := log.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("durable: %w", err)
+	}
+	m.offset = offset
+	return nil
+}
+
+// apply decodes the record and applies it to the map.
+func (m *Map[K, V]) apply(r record) error {
+	switch r.op {
+	case opClear:
+		m.m.Clear()
+		return nil
+	case opPut, opRemove:
+	default:
+		return fmt.Errorf("durable: unknown record op %d", r.op)
+	}
+	key, err := m.options.KeyCodec.Decode(r.key)
+	if err != nil {
+		return fmt.Errorf("durable: decode key: %w", err)
+	}
+	if r.op == opRemove {
+		m.m.Remove(key)
+		return nil
+	}
+	value, err := m.options.ValueCodec.Decode(r.value)
+	if err != nil {
+		return fmt.Errorf("durable: decode value: %w", err)
+	}
+	m.m.Put(key, value)
+	return nil
+}
+
+// syncDir syncs the directory so that a rename within it is durable.
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
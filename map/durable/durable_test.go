@@ -0,0 +1,135 @@
+package durable
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testOptions() Options[int, string] {
+	return Options[int, string]{
+		Comparator: utils.NumbersComparator[int],
+		KeyCodec:   utils.IntCodec{},
+		ValueCodec: utils.StringCodec{},
+	}
+}
+
+func openTest(t *testing.T, dir string, options Options[int, string]) *Map[int, string] {
+	m, err := Open(dir, options)
+	assert.NoError(t, err)
+	return m
+}
+
+func TestDurableReplay(t *testing.T) {
+	dir := t.TempDir()
+	m := openTest(t, dir, testOptions())
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, m.Put(i, strconv.Itoa(i)))
+	}
+	assert.NoError(t, m.Put(3, "three"))
+	assert.NoError(t, m.Remove(4))
+	assert.NoError(t, m.Remove(42))
+	assert.NoError(t, m.Close())
+	assert.ErrorIs(t, m.Put(1, "x"), ErrClosed)
+
+	m = openTest(t, dir, testOptions())
+	assert.Equal(t, []int{0, 1, 2, 3, 5, 6, 7, 8, 9}, m.Keys())
+	value, _ := m.Get(3)
+	assert.Equal(t, "three", value)
+
+	assert.NoError(t, m.Clear())
+	assert.NoError(t, m.Put(100, "a"))
+	assert.NoError(t, m.Close())
+	m = openTest(t, dir, testOptions())
+	assert.Equal(t, []int{100}, m.Keys())
+	assert.NoError(t, m.Close())
+}
+
+func TestDurableTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	m := openTest(t, dir, testOptions())
+	assert.NoError(t, m.Put(1, "a"))
+	assert.NoError(t, m.Put(2, "b"))
+	assert.NoError(t, m.Close())
+
+	path := filepath.Join(dir, logName)
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	intact := info.Size()
+	record := appendRecord(nil, record{op: opPut, key: []byte("3"), value: []byte("c")})
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	assert.NoError(t, err)
+	_, err = file.Write(record[:len(record)-1])
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	m = openTest(t, dir, testOptions())
+	assert.Equal(t, []int{1, 2}, m.Keys())
+	info, err = os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, intact, info.Size())
+
+	// records written after the truncation are replayed
+	assert.NoError(t, m.Put(4, "d"))
+	assert.NoError(t, m.Close())
+	m = openTest(t, dir, testOptions())
+	assert.Equal(t, []int{1, 2, 4}, m.Keys())
+	assert.NoError(t, m.Close())
+}
+
+func TestDurableChecksum(t *testing.T) {
+	dir := t.TempDir()
+	m := openTest(t, dir, testOptions())
+	assert.NoError(t, m.Put(1, "a"))
+	assert.NoError(t, m.Put(2, "b"))
+	assert.NoError(t, m.Close())
+
+	path := filepath.Join(dir, logName)
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	assert.NoError(t, os.WriteFile(path, data, 0o644))
+
+	m = openTest(t, dir, testOptions())
+	assert.Equal(t, []int{1}, m.Keys())
+	assert.NoError(t, m.Close())
+}
+
+func TestDurableSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	options := testOptions()
+	options.SnapshotEvery = 4
+	options.Sync = SyncNever
+	m := openTest(t, dir, options)
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, m.Put(i, strconv.Itoa(i)))
+	}
+	assert.NoError(t, m.Remove(0))
+	assert.NoError(t, m.Close())
+
+	info, err := os.Stat(filepath.Join(dir, logName))
+	assert.NoError(t, err)
+	// snapshots were taken after the 4th and 8th mutations, the last three are still logged
+	put := appendRecord(nil, record{op: opPut, key: []byte("9"), value: []byte("9")})
+	remove := appendRecord(nil, record{op: opRemove, key: []byte("0")})
+	assert.Equal(t, int64(2*len(put)+len(remove)), info.Size())
+
+	m = openTest(t, dir, options)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, m.Keys())
+	assert.NoError(t, m.Snapshot())
+	assert.NoError(t, m.Close())
+	m = openTest(t, dir, options)
+	assert.Equal(t, 9, m.Size())
+	assert.NoError(t, m.Close())
+
+	data, err := os.ReadFile(filepath.Join(dir, snapshotName))
+	assert.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, snapshotName), data, 0o644))
+	_, err = Open(dir, options)
+	assert.ErrorIs(t, err, ErrCorruptSnapshot)
+}
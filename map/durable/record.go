@@ -0,0 +1,88 @@
+package durable
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+// op is the kind of a logged mutation.
+type op byte
+
+const (
+	opPut op = iota + 1
+	opRemove
+	opClear
+)
+
+const (
+	// headerSize is the size of the length and checksum preceding every record body.
+	headerSize = 8
+	// maxRecordSize bounds the body of a record, anything larger is treated as damaged.
+	maxRecordSize = 1 << 30
+)
+
+// errDamaged is returned by readRecord for a record that is cut short or fails its checksum.
+var errDamaged = errors.New("durable: damaged record")
+
+// record is a single logged mutation. Value is only set for puts, Key for puts and removes.
+type record struct {
+	op    op
+	key   []byte
+	value []byte
+}
+
+// appendRecord appends the encoding of the record to buf:
+// the body length and its CRC-32, followed by the op, the uvarint key length, the key and the value.
+func appendRecord(buf []byte, r record) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, headerSize)...)
+	buf = append(buf, byte(r.op))
+	var keyLength [binary.MaxVarintLen64]byte
+	buf = append(buf, keyLength[:binary.PutUvarint(keyLength[:], uint64(len(r.key)))]...)
+	buf = append(buf, r.key...)
+	buf = append(buf, r.value...)
+	body := buf[start+headerSize:]
+	binary.BigEndian.PutUint32(buf[start:], uint32(len(body)))
+	binary.BigEndian.PutUint32(buf[start+4:], crc32.ChecksumIEEE(body))
+	return buf
+}
+
+// readRecord reads the next record and returns its encoded size.
+// Returns io.EOF at a clean end of the stream and errDamaged for a torn or corrupted record.
+func readRecord(reader *bufio.Reader) (r record, size int, err error) {
+	var header [headerSize]byte
+	if n, err := io.ReadFull(reader, header[:]); err != nil {
+		if err == io.EOF && n == 0 {
+			return r, 0, io.EOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return r, 0, errDamaged
+		}
+		return r, 0, err
+	}
+	length := binary.BigEndian.Uint32(header[:4])
+	if length == 0 || length > maxRecordSize {
+		return r, 0, errDamaged
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return r, 0, errDamaged
+		}
+		return r, 0, err
+	}
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(header[4:]) {
+		return r, 0, errDamaged
+	}
+	r.op = op(body[0])
+	keyLength, n := binary.Uvarint(body[1:])
+	if n <= 0 || keyLength > uint64(len(body)-1-n) {
+		return r, 0, errDamaged
+	}
+	r.key = body[1+n : 1+n+int(keyLength)]
+	r.value = body[1+n+int(keyLength):]
+	return r, headerSize + int(length), nil
+}
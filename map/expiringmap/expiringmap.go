@@ -0,0 +1,211 @@
+// Package expiringmap provides a tree map whose entries expire after a time to live.
+//
+// Entries are held in a primary red-black tree by key. Entries put with a time to live are also
+// held, from the moment they are put, in a secondary red-black tree ordered by expiry time and key,
+// so expired entries are found in O(log n) each.
+package expiringmap
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Map holds the entries in a tree by key and their expiry times in a tree by time.
+// Expired entries are evicted lazily when they are read, when the map is sized by Size or Empty,
+// or by ExpireUntil. Keys, Values and Each skip expired entries without evicting them.
+type Map[K any, V any] struct {
+	entries *redblacktree.Tree[K, entry[V]]
+	expiry  *redblacktree.Tree[deadline[K], struct{}]
+	clock   func() time.Time
+	onEvict func(key K, value V)
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+	expires   bool
+}
+
+type deadline[K any] struct {
+	at  time.Time
+	key K
+}
+
+// New instantiates an expiring map with the custom key comparator, using time.Now as its clock.
+func New[K any, V any](comparator utils.Comparator[K]) *Map[K, V] {
+	return &Map[K, V]{
+		entries: redblacktree.NewWithComparator[K, entry[V]](comparator),
+		expiry: redblacktree.NewWithComparator[deadline[K], struct{}](func(a, b deadline[K]) int {
+			if compare := utils.TimeComparator(a.at, b.at); compare != 0 {
+				return compare
+			}
+			return comparator(a.key, b.key)
+		}),
+		clock: time.Now,
+	}
+}
+
+// SetClock replaces the clock telling the map the current time, e.g. with a fake one in tests.
+func (m *Map[K, V]) SetClock(clock func() time.Time) {
+	m.clock = clock
+}
+
+// OnEvict registers f to be called with every entry evicted because it expired.
+// It is not called for entries removed or replaced explicitly.
+func (m *Map[K, V]) OnEvict(f func(key K, value V)) {
+	m.onEvict = f
+}
+
+// Put inserts key-value pair into the map, expiring after ttl. A ttl of zero or less never expires.
+func (m *Map[K, V]) Put(key K, value V, ttl time.Duration) {
+	if ttl <= 0 {
+		m.put(key, entry[V]{value: value})
+		return
+	}
+	m.put(key, entry[V]{value: value, expiresAt: m.clock().Add(ttl), expires: true})
+}
+
+// PutUntil inserts key-value pair into the map, expiring at the given time.
+func (m *Map[K, V]) PutUntil(key K, value V, expiresAt time.Time) {
+	m.put(key, entry[V]{value: value, expiresAt: expiresAt, expires: true})
+}
+
+// Get searches the element in the map by key and returns its value or zero value if key is not found.
+// An expired entry is evicted and reported as not found.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	e, found := m.entries.Get(key)
+	if !found {
+		return value, false
+	}
+	if m.expired(e, m.clock()) {
+		m.evict(key, e)
+		return value, false
+	}
+	return e.value, true
+}
+
+// TTL returns the time left until the key expires.
+// Second return parameter is false if the key is not found or never expires.
+func (m *Map[K, V]) TTL(key K) (ttl time.Duration, found bool) {
+	e, found := m.entries.Get(key)
+	if !found || !e.expires {
+		return 0, false
+	}
+	if ttl = e.expiresAt.Sub(m.clock()); ttl < 0 {
+		ttl = 0
+	}
+	return ttl, true
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, V]) Remove(key K) {
+	if e, found := m.entries.Get(key); found {
+		m.entries.Remove(key)
+		if e.expires {
+			m.expiry.Remove(deadline[K]{at: e.expiresAt, key: key})
+		}
+	}
+}
+
+// ExpireUntil evicts every entry expiring at or before now and returns them in expiry order.
+func (m *Map[K, V]) ExpireUntil(now time.Time) []treemap.Entry[K, V] {
+	var evicted []treemap.Entry[K, V]
+	for {
+		next := m.expiry.Left()
+		if next == nil || next.Key.at.After(now) {
+			return evicted
+		}
+		key := next.Key.key
+		e, _ := m.entries.Get(key)
+		m.evict(key, e)
+		evicted = append(evicted, treemap.Entry[K, V]{Key: key, Value: e.value})
+	}
+}
+
+// Expire evicts every entry expired according to the clock and returns them in expiry order.
+func (m *Map[K, V]) Expire() []treemap.Entry[K, V] {
+	return m.ExpireUntil(m.clock())
+}
+
+// Empty returns true if map does not contain any unexpired elements.
+// Expired entries are evicted first, as by Expire.
+func (m *Map[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of unexpired elements in the map.
+// Expired entries are evicted first, as by Expire.
+func (m *Map[K, V]) Size() int {
+	m.Expire()
+	return m.entries.Size()
+}
+
+// Keys returns all unexpired keys in-order.
+func (m *Map[K, V]) Keys() []K {
+	var keys []K
+	m.Each(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Values returns all unexpired values in-order based on the key.
+func (m *Map[K, V]) Values() []V {
+	var values []V
+	m.Each(func(_ K, value V) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// Each calls the given function once for each unexpired element in-order, passing that element's key and value.
+func (m *Map[K, V]) Each(f func(key K, value V)) {
+	now := m.clock()
+	for it := m.entries.Iterator(); it.Next(); {
+		if e := it.Value(); !m.expired(e, now) {
+			f(it.Key(), e.value)
+		}
+	}
+}
+
+// Clear removes all elements from the map without calling the eviction callback.
+func (m *Map[K, V]) Clear() {
+	m.entries.Clear()
+	m.expiry.Clear()
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "ExpiringMap\nmap["
+	m.Each(func(key K, value V) {
+		str += fmt.Sprintf("%v:%v ", key, value)
+	})
+	return strings.TrimRight(str, " ") + "]"
+}
+
+func (m *Map[K, V]) put(key K, e entry[V]) {
+	if previous, loaded := m.entries.Swap(key, e); loaded && previous.expires {
+		m.expiry.Remove(deadline[K]{at: previous.expiresAt, key: key})
+	}
+	if e.expires {
+		m.expiry.Put(deadline[K]{at: e.expiresAt, key: key}, struct{}{})
+	}
+}
+
+func (m *Map[K, V]) expired(e entry[V], now time.Time) bool {
+	return e.expires && !now.Before(e.expiresAt)
+}
+
+func (m *Map[K, V]) evict(key K, e entry[V]) {
+	m.entries.Remove(key)
+	m.expiry.Remove(deadline[K]{at: e.expiresAt, key: key})
+	if m.onEvict != nil {
+		m.onEvict(key, e.value)
+	}
+}
@@ -0,0 +1,193 @@
+package expiringmap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func TestExpiringMapPutGet(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	m.Put("a", 1, time.Minute)
+	m.Put("forever", 2, 0)
+
+	value, found := m.Get("a")
+	assert.Equal(t, 1, value)
+	assert.True(t, found)
+	value, found = m.Get("forever")
+	assert.Equal(t, 2, value)
+	assert.True(t, found)
+	_, found = m.Get("missing")
+	assert.False(t, found)
+}
+
+func TestExpiringMapTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	m.Put("a", 1, time.Minute)
+	m.Put("forever", 2, 0)
+
+	ttl, found := m.TTL("a")
+	assert.Equal(t, time.Minute, ttl)
+	assert.True(t, found)
+	_, found = m.TTL("forever")
+	assert.False(t, found)
+	_, found = m.TTL("missing")
+	assert.False(t, found)
+
+	clock.now = clock.now.Add(time.Hour)
+	ttl, found = m.TTL("a")
+	assert.Equal(t, time.Duration(0), ttl)
+	assert.True(t, found)
+}
+
+func TestExpiringMapGetEvictsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	var evicted []string
+	m.OnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	m.Put("a", 1, time.Minute)
+	m.Put("b", 2, 2*time.Minute)
+
+	clock.now = clock.now.Add(time.Minute)
+	_, found := m.Get("a")
+	assert.False(t, found)
+	value, found := m.Get("b")
+	assert.Equal(t, 2, value)
+	assert.True(t, found)
+	assert.Equal(t, []string{"a"}, evicted)
+}
+
+func TestExpiringMapKeysSkipExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	var evicted []string
+	m.OnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	m.Put("a", 1, time.Minute)
+	m.Put("b", 2, 0)
+
+	clock.now = clock.now.Add(time.Hour)
+	assert.Equal(t, []string{"b"}, m.Keys())
+	assert.Equal(t, []int{2}, m.Values())
+	assert.Equal(t, "ExpiringMap\nmap[b:2]", m.String())
+	assert.Empty(t, evicted)
+}
+
+func TestExpiringMapSizeEvictsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	var evicted []string
+	m.OnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	m.Put("a", 1, time.Minute)
+	m.Put("b", 2, time.Hour)
+	assert.Equal(t, 2, m.Size())
+
+	clock.now = clock.now.Add(time.Minute)
+	assert.Equal(t, 1, m.Size())
+	assert.Equal(t, []string{"a"}, evicted)
+	assert.False(t, m.Empty())
+
+	clock.now = clock.now.Add(time.Hour)
+	assert.True(t, m.Empty())
+	assert.Equal(t, []string{"a", "b"}, evicted)
+}
+
+func TestExpiringMapReplaceDropsDeadline(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	m.Put("a", 1, time.Minute)
+	m.Put("a", 10, time.Hour)
+
+	clock.now = clock.now.Add(time.Minute)
+	assert.Empty(t, m.Expire())
+	value, found := m.Get("a")
+	assert.Equal(t, 10, value)
+	assert.True(t, found)
+
+	m.Put("a", 100, 0)
+	assert.Equal(t, 0, m.expiry.Size())
+}
+
+func TestExpiringMapRemoveDropsDeadline(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	m.PutUntil("a", 1, clock.now.Add(time.Minute))
+	m.Remove("a")
+	m.Remove("missing")
+
+	assert.Equal(t, 0, m.expiry.Size())
+	assert.Empty(t, m.ExpireUntil(clock.now.Add(time.Hour)))
+}
+
+func TestExpiringMapExpireUntil(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	var evicted []string
+	m.OnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	m.Put("c", 3, 3*time.Minute)
+	m.Put("b", 2, time.Minute)
+	m.Put("a", 1, time.Minute)
+	m.Put("d", 4, 0)
+
+	assert.Empty(t, m.ExpireUntil(clock.now))
+	assert.Equal(t, []treemap.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, m.ExpireUntil(clock.now.Add(2*time.Minute)))
+	assert.Equal(t, []string{"a", "b"}, evicted)
+	assert.Equal(t, []string{"c", "d"}, m.Keys())
+}
+
+func TestExpiringMapExpire(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	m := New[string, int](utils.StringComparator)
+	m.SetClock(clock.Now)
+	m.Put("a", 1, time.Minute)
+	m.Put("b", 2, 0)
+
+	assert.Empty(t, m.Expire())
+	clock.now = clock.now.Add(time.Minute)
+	assert.Equal(t, []treemap.Entry[string, int]{{Key: "a", Value: 1}}, m.Expire())
+	assert.Equal(t, 0, m.expiry.Size())
+}
+
+func TestExpiringMapClear(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	var evicted []string
+	m.OnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	m.Put("a", 1, time.Minute)
+	m.Put("b", 2, 0)
+
+	m.Clear()
+	assert.True(t, m.Empty())
+	assert.Equal(t, 0, m.expiry.Size())
+	assert.Empty(t, evicted)
+	assert.Equal(t, "ExpiringMap\nmap[]", m.String())
+}
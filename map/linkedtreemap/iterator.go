@@ -0,0 +1,166 @@
+package linkedtreemap
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// Iterator walks the map in key order.
+type Iterator[K any, V any] struct {
+	iterator redblacktree.Iterator[K, *link[K, V]]
+}
+
+// Iterator returns a stateful iterator over the key/value pairs in key order.
+func (m *Map[K, V]) Iterator() Iterator[K, V] {
+	return Iterator[K, V]{iterator: m.tree.Iterator()}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Value() V {
+	return iterator.iterator.Value().value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Key() K {
+	return iterator.iterator.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[K, V]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[K, V]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator[K, V]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Last() bool {
+	return iterator.iterator.Last()
+}
+
+// InsertionIterator walks the map in insertion order.
+type InsertionIterator[K any, V any] struct {
+	m        *Map[K, V]
+	link     *link[K, V]
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// InsertionIterator returns a stateful iterator over the key/value pairs in insertion order.
+// The current element must not be removed or moved while iterating.
+func (m *Map[K, V]) InsertionIterator() InsertionIterator[K, V] {
+	return InsertionIterator[K, V]{m: m, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *InsertionIterator[K, V]) Next() bool {
+	switch iterator.position {
+	case begin:
+		iterator.link = iterator.m.head
+	case between:
+		iterator.link = iterator.link.next
+	case end:
+		return false
+	}
+	if iterator.link == nil {
+		iterator.position = end
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *InsertionIterator[K, V]) Prev() bool {
+	switch iterator.position {
+	case begin:
+		return false
+	case between:
+		iterator.link = iterator.link.prev
+	case end:
+		iterator.link = iterator.m.tail
+	}
+	if iterator.link == nil {
+		iterator.position = begin
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *InsertionIterator[K, V]) Value() V {
+	return iterator.link.value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *InsertionIterator[K, V]) Key() K {
+	return iterator.link.key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *InsertionIterator[K, V]) Begin() {
+	iterator.link, iterator.position = nil, begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *InsertionIterator[K, V]) End() {
+	iterator.link, iterator.position = nil, end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *InsertionIterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *InsertionIterator[K, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
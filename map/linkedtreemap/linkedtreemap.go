@@ -0,0 +1,270 @@
+// Package linkedtreemap provides a tree map that also remembers the order its keys were inserted in.
+//
+// Entries are held in a red-black tree for ordered access and threaded on an intrusive doubly linked list
+// for insertion order, so both orders are walked in O(1) per step and reordering is O(log n).
+package linkedtreemap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Map holds the elements in a red-black tree and a doubly linked list in insertion order.
+type Map[K any, V any] struct {
+	tree *redblacktree.Tree[K, *link[K, V]]
+	head *link[K, V]
+	tail *link[K, V]
+}
+
+// link is an entry of the map threaded on the insertion order list.
+type link[K any, V any] struct {
+	key   K
+	value V
+	prev  *link[K, V]
+	next  *link[K, V]
+}
+
+// New instantiates a linked tree map with the custom comparator.
+func New[K any, V any](comparator utils.Comparator[K]) *Map[K, V] {
+	return &Map[K, V]{tree: redblacktree.NewWithComparator[K, *link[K, V]](comparator)}
+}
+
+// Put inserts key-value pair into the map.
+// A new key is appended to the insertion order, a present key keeps its place.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Put(key K, value V) {
+	l, loaded := m.tree.GetOrPut(key, func() *link[K, V] {
+		return &link[K, V]{key: key}
+	})
+	l.value = value
+	if !loaded {
+		m.pushBack(l)
+	}
+}
+
+// Get searches the element in the map by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	if l, found := m.tree.Get(key); found {
+		return l.value, true
+	}
+	return value, false
+}
+
+// Remove removes the element from the map by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Remove(key K) {
+	if l, found := m.tree.Get(key); found {
+		m.tree.Remove(key)
+		m.unlink(l)
+	}
+}
+
+// MoveToFront moves the key to the front of the insertion order.
+// Returns false if the key is not found.
+func (m *Map[K, V]) MoveToFront(key K) bool {
+	l, found := m.tree.Get(key)
+	if !found {
+		return false
+	}
+	m.unlink(l)
+	m.pushFront(l)
+	return true
+}
+
+// MoveToBack moves the key to the back of the insertion order, as if it was inserted last.
+// Returns false if the key is not found.
+func (m *Map[K, V]) MoveToBack(key K) bool {
+	l, found := m.tree.Get(key)
+	if !found {
+		return false
+	}
+	m.unlink(l)
+	m.pushBack(l)
+	return true
+}
+
+// Empty returns true if map does not contain any elements.
+func (m *Map[K, V]) Empty() bool {
+	return m.tree.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return m.tree.Size()
+}
+
+// Keys returns all keys in key order.
+func (m *Map[K, V]) Keys() []K {
+	return m.tree.Keys()
+}
+
+// Values returns all values in key order.
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, 0, m.tree.Size())
+	for it := m.tree.Iterator(); it.Next(); {
+		values = append(values, it.Value().value)
+	}
+	return values
+}
+
+// InsertionKeys returns all keys in insertion order.
+func (m *Map[K, V]) InsertionKeys() []K {
+	keys := make([]K, 0, m.tree.Size())
+	for l := m.head; l != nil; l = l.next {
+		keys = append(keys, l.key)
+	}
+	return keys
+}
+
+// InsertionValues returns all values in insertion order.
+func (m *Map[K, V]) InsertionValues() []V {
+	values := make([]V, 0, m.tree.Size())
+	for l := m.head; l != nil; l = l.next {
+		values = append(values, l.value)
+	}
+	return values
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns zero values if map is empty.
+func (m *Map[K, V]) Min() (key K, value V) {
+	if node := m.tree.Left(); node != nil {
+		return node.Key, node.Value.value
+	}
+	return
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns zero values if map is empty.
+func (m *Map[K, V]) Max() (key K, value V) {
+	if node := m.tree.Right(); node != nil {
+		return node.Key, node.Value.value
+	}
+	return
+}
+
+// MinEntry returns the entry with the minimum key.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) MinEntry() (entry treemap.Entry[K, V], found bool) {
+	return entryOf(m.tree.Left())
+}
+
+// MaxEntry returns the entry with the maximum key.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) MaxEntry() (entry treemap.Entry[K, V], found bool) {
+	return entryOf(m.tree.Right())
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be zero values.
+// Use FloorEntry to tell a missing floor apart from a zero key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Floor(key K) (foundkey K, foundvalue V) {
+	entry, _ := m.FloorEntry(key)
+	return entry.Key, entry.Value
+}
+
+// FloorEntry finds the entry with the largest key smaller than or equal to the input key.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) FloorEntry(key K) (entry treemap.Entry[K, V], found bool) {
+	node, _ := m.tree.Floor(key)
+	return entryOf(node)
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be zero values.
+// Use CeilingEntry to tell a missing ceiling apart from a zero key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Ceiling(key K) (foundkey K, foundvalue V) {
+	entry, _ := m.CeilingEntry(key)
+	return entry.Key, entry.Value
+}
+
+// CeilingEntry finds the entry with the smallest key larger than or equal to the input key.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) CeilingEntry(key K) (entry treemap.Entry[K, V], found bool) {
+	node, _ := m.tree.Ceiling(key)
+	return entryOf(node)
+}
+
+// Each calls the given function once for each element in key order, passing that element's key and value.
+func (m *Map[K, V]) Each(f func(key K, value V)) {
+	for it := m.tree.Iterator(); it.Next(); {
+		f(it.Key(), it.Value().value)
+	}
+}
+
+// EachInsertion calls the given function once for each element in insertion order, passing that element's key and value.
+func (m *Map[K, V]) EachInsertion(f func(key K, value V)) {
+	for l := m.head; l != nil; l = l.next {
+		f(l.key, l.value)
+	}
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, V]) Clear() {
+	m.tree.Clear()
+	m.head, m.tail = nil, nil
+}
+
+// String returns a string representation of container, in insertion order
+func (m *Map[K, V]) String() string {
+	str := "LinkedTreeMap\nmap["
+	m.EachInsertion(func(key K, value V) {
+		str += fmt.Sprintf("%v:%v ", key, value)
+	})
+	return strings.TrimRight(str, " ") + "]"
+}
+
+func entryOf[K any, V any](node *redblacktree.Node[K, *link[K, V]]) (entry treemap.Entry[K, V], found bool) {
+	if node == nil {
+		return entry, false
+	}
+	return treemap.Entry[K, V]{Key: node.Key, Value: node.Value.value}, true
+}
+
+func (m *Map[K, V]) pushBack(l *link[K, V]) {
+	l.prev, l.next = m.tail, nil
+	if m.tail != nil {
+		m.tail.next = l
+	} else {
+		m.head = l
+	}
+	m.tail = l
+}
+
+func (m *Map[K, V]) pushFront(l *link[K, V]) {
+	l.prev, l.next = nil, m.head
+	if m.head != nil {
+		m.head.prev = l
+	} else {
+		m.tail = l
+	}
+	m.head = l
+}
+
+func (m *Map[K, V]) unlink(l *link[K, V]) {
+	if l.prev != nil {
+		l.prev.next = l.next
+	} else {
+		m.head = l.next
+	}
+	if l.next != nil {
+		l.next.prev = l.prev
+	} else {
+		m.tail = l.prev
+	}
+	l.prev, l.next = nil, nil
+}
@@ -0,0 +1,190 @@
+package linkedtreemap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkedTreeMapPut(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("a", 10) // overwrite keeps the insertion position
+
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, []string{"a", "b", "c"}, m.Keys())
+	assert.Equal(t, []int{10, 2, 3}, m.Values())
+	assert.Equal(t, []string{"c", "a", "b"}, m.InsertionKeys())
+	assert.Equal(t, []int{3, 10, 2}, m.InsertionValues())
+
+	value, found := m.Get("a")
+	assert.Equal(t, 10, value)
+	assert.True(t, found)
+	_, found = m.Get("z")
+	assert.False(t, found)
+}
+
+func TestLinkedTreeMapRemove(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	m.Remove("a")
+	m.Remove("z")
+	assert.Equal(t, []string{"c", "b"}, m.InsertionKeys())
+	assert.Equal(t, []string{"b", "c"}, m.Keys())
+
+	// a removed key is appended when it is put again
+	m.Put("a", 1)
+	assert.Equal(t, []string{"c", "b", "a"}, m.InsertionKeys())
+}
+
+func TestLinkedTreeMapMove(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	assert.True(t, m.MoveToFront("b"))
+	assert.Equal(t, []string{"b", "c", "a"}, m.InsertionKeys())
+	assert.True(t, m.MoveToBack("c"))
+	assert.Equal(t, []string{"b", "a", "c"}, m.InsertionKeys())
+	assert.False(t, m.MoveToFront("z"))
+	assert.False(t, m.MoveToBack("z"))
+	assert.Equal(t, []string{"a", "b", "c"}, m.Keys())
+}
+
+func TestLinkedTreeMapNavigation(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	entry, found := m.FloorEntry("bb")
+	assert.Equal(t, treemap.Entry[string, int]{Key: "b", Value: 2}, entry)
+	assert.True(t, found)
+	entry, found = m.CeilingEntry("bb")
+	assert.Equal(t, treemap.Entry[string, int]{Key: "c", Value: 3}, entry)
+	assert.True(t, found)
+	_, found = m.CeilingEntry("d")
+	assert.False(t, found)
+
+	key, _ := m.Min()
+	assert.Equal(t, "a", key)
+	key, _ = m.Max()
+	assert.Equal(t, "c", key)
+}
+
+func TestLinkedTreeMapString(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	assert.Equal(t, "LinkedTreeMap\nmap[b:2 a:1]", m.String())
+}
+
+func TestLinkedTreeMapClear(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	m.Clear()
+	assert.True(t, m.Empty())
+	assert.Empty(t, m.InsertionKeys())
+	m.Put("x", 1)
+	assert.Equal(t, []string{"x"}, m.InsertionKeys())
+}
+
+func TestLinkedTreeMapIterator(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	var keys []string
+	for it := m.Iterator(); it.Next(); {
+		keys = append(keys, it.Key())
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+}
+
+func TestLinkedTreeMapInsertionIterator(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	var keys []string
+	it := m.InsertionIterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, keys)
+	assert.False(t, it.Next())
+
+	keys = nil
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	assert.Equal(t, []string{"b", "a", "c"}, keys)
+
+	assert.True(t, it.Last())
+	assert.Equal(t, 2, it.Value())
+	assert.True(t, it.First())
+	assert.Equal(t, 3, it.Value())
+}
+
+func TestLinkedTreeMapInsertionIteratorEmpty(t *testing.T) {
+	it := New[string, int](utils.StringComparator).InsertionIterator()
+
+	assert.False(t, it.Next())
+	assert.False(t, it.Prev())
+	assert.False(t, it.First())
+	assert.False(t, it.Last())
+}
+
+func TestLinkedTreeMapMarshalJSON(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"c":3,"a":1,"b":2}`, string(data))
+}
+
+func TestLinkedTreeMapUnmarshalJSON(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"z":1,"y":2,"x":3}`), m))
+	assert.Equal(t, []string{"z", "y", "x"}, m.InsertionKeys())
+	assert.Equal(t, []string{"x", "y", "z"}, m.Keys())
+}
+
+func TestLinkedTreeMapJSONNonStringKeys(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int])
+	m.Put(10, "ten")
+	m.Put(2, "two")
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10":"ten","2":"two"}`, string(data))
+
+	decoded := New[int, string](utils.NumbersComparator[int])
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, []int{10, 2}, decoded.InsertionKeys())
+}
+
+func TestLinkedTreeMapUnmarshalJSONErrors(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), m))
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), &Map[string, int]{}))
+}
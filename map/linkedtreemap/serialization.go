@@ -0,0 +1,75 @@
+package linkedtreemap
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MarshalJSON outputs the JSON object of the map with its keys in insertion order.
+// Keys are encoded like encoding/json encodes map keys: strings as is, other keys by their JSON text.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for l := m.head; l != nil; l = l.next {
+		if l != m.head {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(l.key)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) == 0 || key[0] != '"' {
+			if key, err = json.Marshal(string(key)); err != nil {
+				return nil, err
+			}
+		}
+		value, err := json.Marshal(l.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the elements of the map by the ones of the JSON object, inserted in the object's order.
+// The map must have been instantiated with New beforehand.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if m.tree == nil {
+		return errors.New("linkedtreemap: unmarshal into a map not instantiated with New")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil {
+		return err
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("linkedtreemap: expected a JSON object, got %v", token)
+	}
+	m.Clear()
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		text := token.(string)
+		var key K
+		// string keys are taken as is, other keys are parsed from their JSON text
+		quoted, _ := json.Marshal(text)
+		if err := json.Unmarshal(quoted, &key); err != nil {
+			if err := json.Unmarshal([]byte(text), &key); err != nil {
+				return fmt.Errorf("linkedtreemap: decode key %q: %w", text, err)
+			}
+		}
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		m.Put(key, value)
+	}
+	_, err := decoder.Token()
+	return err
+}
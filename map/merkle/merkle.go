@@ -0,0 +1,289 @@
+// Package merkle maintains a Merkle summary over the key ranges of a tree map,
+// so two replicas can find the ranges they differ in without shipping every entry.
+//
+// The key space is split into Fanout^Depth leaf buckets by a monotone bucket function,
+// each leaf holding the sum of the hashes of its entries and each inner node the hash of its children.
+// Buckets are fixed ranges of the key space rather than ranks,
+// so an entry present on only one replica does not shift every bucket after it.
+package merkle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// maxBuckets bounds the number of leaf buckets of a summary.
+const maxBuckets = 1 << 24
+
+// ErrShapeMismatch is returned when two summaries do not have the same fanout and depth.
+var ErrShapeMismatch = errors.New("merkle: summaries have different shapes")
+
+// Config describes the shape of a summary and how entries are hashed.
+type Config[K any, V any] struct {
+	// Fanout is the number of children of every inner node, at least 2.
+	Fanout int
+	// Depth is the number of levels below the root, so the summary has Fanout^Depth leaf buckets.
+	Depth int
+	// Bucket maps a key to its leaf bucket. It must be non-decreasing in key order;
+	// results beyond the last bucket are clamped to it.
+	Bucket func(key K) uint64
+	// BucketStart returns the smallest key that Bucket maps to the bucket or a later one,
+	// so Entries can seek to a bucket instead of walking every key before it.
+	BucketStart func(bucket uint64) K
+	// KeyHash and ValueHash hash the entries of the map.
+	KeyHash   func(key K) uint64
+	ValueHash func(value V) uint64
+	// KeyCodec and ValueCodec encode entries for Reconcile.
+	KeyCodec   utils.Codec[K]
+	ValueCodec utils.Codec[V]
+}
+
+// Map is a tree map with a Merkle summary kept up to date on every Put and Remove.
+// The wrapped map must not be modified other than through the Map.
+type Map[K any, V any] struct {
+	m      *treemap.Map[K, V]
+	config Config[K, V]
+	levels [][]uint64 // levels[0] holds the root, levels[Depth] the leaf buckets
+}
+
+// Summary is a snapshot of the hashes of every node of a Map, root first.
+type Summary struct {
+	Fanout int        `json:"fanout"`
+	Levels [][]uint64 `json:"levels"`
+}
+
+// BucketRange is an inclusive range of leaf buckets.
+type BucketRange struct {
+	First uint64 `json:"first"`
+	Last  uint64 `json:"last"`
+}
+
+// New wraps the map and computes its summary in O(n).
+// Panics if the fanout is below 2, the depth is negative, the bucket count exceeds 2^24
+// or a bucket or hash function is missing.
+func New[K any, V any](m *treemap.Map[K, V], config Config[K, V]) *Map[K, V] {
+	if config.Fanout < 2 || config.Depth < 0 {
+		panic("merkle: fanout must be at least 2 and depth non-negative")
+	}
+	if config.Bucket == nil || config.BucketStart == nil || config.KeyHash == nil || config.ValueHash == nil {
+		panic("merkle: bucket and hash functions are required")
+	}
+	levels := make([][]uint64, config.Depth+1)
+	width := 1
+	for depth := range levels {
+		levels[depth] = make([]uint64, width)
+		if depth < config.Depth {
+			if width *= config.Fanout; width > maxBuckets {
+				panic("merkle: too many buckets")
+			}
+		}
+	}
+	merkle := &Map[K, V]{m: m, config: config, levels: levels}
+	leaves := levels[config.Depth]
+	for it := m.Iterator(); it.Next(); {
+		leaves[merkle.bucket(it.Key())] += merkle.entryHash(it.Key(), it.Value())
+	}
+	for depth := config.Depth - 1; depth >= 0; depth-- {
+		for index := range levels[depth] {
+			merkle.rehash(depth, index)
+		}
+	}
+	return merkle
+}
+
+// StringBucket returns a bucket function spreading strings over the given number of buckets
+// by their leading bytes, preserving their byte-wise order.
+func StringBucket(buckets uint64) func(key string) uint64 {
+	return func(key string) uint64 {
+		var prefix uint64
+		for i := 0; i < 8; i++ {
+			prefix <<= 8
+			if i < len(key) {
+				prefix |= uint64(key[i])
+			}
+		}
+		hi, _ := bits.Mul64(prefix, buckets)
+		return hi
+	}
+}
+
+// StringBucketStart returns the BucketStart function matching StringBucket with the same number of buckets.
+func StringBucketStart(buckets uint64) func(bucket uint64) string {
+	return func(bucket uint64) string {
+		if bucket >= buckets {
+			bucket = buckets - 1
+		}
+		// the smallest prefix p with p*buckets >= bucket*2^64
+		prefix, _ := bits.Div64(bucket, buckets-1, buckets)
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, prefix)
+		return string(bytes.TrimRight(key, "\x00"))
+	}
+}
+
+// Put inserts key-value pair into the map and updates the summary in O(log n + Fanout*Depth).
+func (merkle *Map[K, V]) Put(key K, value V) {
+	delta := merkle.entryHash(key, value)
+	if previous, loaded := merkle.m.Swap(key, value); loaded {
+		delta -= merkle.entryHash(key, previous)
+	}
+	merkle.update(key, delta)
+}
+
+// Remove removes the element from the map by key and updates the summary in O(log n + Fanout*Depth).
+func (merkle *Map[K, V]) Remove(key K) {
+	var previous V
+	var found bool
+	merkle.m.Compute(key, func(old V, exists bool) (V, bool) {
+		previous, found = old, exists
+		return old, false
+	})
+	if found {
+		merkle.update(key, -merkle.entryHash(key, previous))
+	}
+}
+
+// Get searches the element in the map by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+func (merkle *Map[K, V]) Get(key K) (value V, found bool) {
+	return merkle.m.Get(key)
+}
+
+// Size returns number of elements in the map.
+func (merkle *Map[K, V]) Size() int {
+	return merkle.m.Size()
+}
+
+// Map returns the wrapped map. It must only be read.
+func (merkle *Map[K, V]) Map() *treemap.Map[K, V] {
+	return merkle.m
+}
+
+// Root returns the hash of the whole map.
+func (merkle *Map[K, V]) Root() uint64 {
+	return merkle.levels[0][0]
+}
+
+// Summary returns a copy of the hashes of every node.
+func (merkle *Map[K, V]) Summary() Summary {
+	levels := make([][]uint64, len(merkle.levels))
+	for depth, level := range merkle.levels {
+		levels[depth] = append([]uint64(nil), level...)
+	}
+	return Summary{Fanout: merkle.config.Fanout, Levels: levels}
+}
+
+// CompareSummaries returns the ranges of leaf buckets whose hashes differ, in-order,
+// descending only into the subtrees that differ.
+// Returns ErrShapeMismatch if the summaries do not have the same fanout and depth.
+func CompareSummaries(a, b Summary) ([]BucketRange, error) {
+	if a.Fanout != b.Fanout || len(a.Levels) != len(b.Levels) {
+		return nil, ErrShapeMismatch
+	}
+	width := 1
+	for depth := range a.Levels {
+		if len(a.Levels[depth]) != width || len(b.Levels[depth]) != width {
+			return nil, ErrShapeMismatch
+		}
+		width *= a.Fanout
+	}
+	frontier := []int{0}
+	for depth := range a.Levels {
+		frontier = differing(a.Levels[depth], b.Levels[depth], frontier)
+		if depth+1 < len(a.Levels) {
+			frontier = children(frontier, a.Fanout)
+		}
+	}
+	return ranges(frontier), nil
+}
+
+// differing returns the nodes of the frontier whose hashes differ.
+func differing(a, b []uint64, frontier []int) []int {
+	var nodes []int
+	for _, index := range frontier {
+		if a[index] != b[index] {
+			nodes = append(nodes, index)
+		}
+	}
+	return nodes
+}
+
+// children returns the children of every node, in-order.
+func children(nodes []int, fanout int) []int {
+	result := make([]int, 0, len(nodes)*fanout)
+	for _, index := range nodes {
+		for child := 0; child < fanout; child++ {
+			result = append(result, index*fanout+child)
+		}
+	}
+	return result
+}
+
+// ranges coalesces sorted bucket indexes into ranges of adjacent buckets.
+func ranges(buckets []int) []BucketRange {
+	var result []BucketRange
+	for _, index := range buckets {
+		if n := len(result); n > 0 && result[n-1].Last+1 == uint64(index) {
+			result[n-1].Last++
+		} else {
+			result = append(result, BucketRange{First: uint64(index), Last: uint64(index)})
+		}
+	}
+	return result
+}
+
+// Entries returns the entries of the map falling into the bucket ranges, in-order,
+// in O(r*log n + k) for r ranges holding k entries.
+// Ranges must be sorted and disjoint, as returned by CompareSummaries.
+func (merkle *Map[K, V]) Entries(buckets []BucketRange) []treemap.Entry[K, V] {
+	var entries []treemap.Entry[K, V]
+	for _, r := range buckets {
+		for it := merkle.m.TailMap(merkle.config.BucketStart(r.First), true).Iterator(); it.Next(); {
+			bucket := uint64(merkle.bucket(it.Key()))
+			if bucket > r.Last {
+				break
+			}
+			if bucket >= r.First {
+				entries = append(entries, treemap.Entry[K, V]{Key: it.Key(), Value: it.Value()})
+			}
+		}
+	}
+	return entries
+}
+
+func (merkle *Map[K, V]) bucket(key K) int {
+	bucket := merkle.config.Bucket(key)
+	if last := uint64(len(merkle.levels[merkle.config.Depth]) - 1); bucket > last {
+		return int(last)
+	}
+	return int(bucket)
+}
+
+func (merkle *Map[K, V]) entryHash(key K, value V) uint64 {
+	return utils.HashCombine(utils.HashCombine(utils.HashSeed, merkle.config.KeyHash(key)), merkle.config.ValueHash(value))
+}
+
+// update adds delta to the bucket of the key and rehashes its ancestors.
+func (merkle *Map[K, V]) update(key K, delta uint64) {
+	index := merkle.bucket(key)
+	merkle.levels[merkle.config.Depth][index] += delta
+	for depth := merkle.config.Depth - 1; depth >= 0; depth-- {
+		index /= merkle.config.Fanout
+		merkle.rehash(depth, index)
+	}
+}
+
+// rehash recomputes the hash of an inner node from its children.
+func (merkle *Map[K, V]) rehash(depth, index int) {
+	fanout := merkle.config.Fanout
+	hash := utils.HashSeed
+	for _, child := range merkle.levels[depth+1][index*fanout : (index+1)*fanout] {
+		hash = utils.HashCombine(hash, child)
+	}
+	merkle.levels[depth][index] = hash
+}
@@ -0,0 +1,318 @@
+package merkle
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func maxResolver(key string, local, remote int) int {
+	if local > remote {
+		return local
+	}
+	return remote
+}
+
+func TestMerkleEmpty(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+
+	assert.Equal(t, a.Root(), b.Root())
+	assert.Equal(t, 0, a.Size())
+	assert.Len(t, a.Summary().Levels, 4)
+	assert.Len(t, a.Summary().Levels[3], 64)
+}
+
+func TestMerkleInsertionOrder(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+
+	for i := 0; i < 100; i++ {
+		a.Put(strconv.Itoa(i), i)
+	}
+	for i := 99; i >= 0; i-- {
+		b.Put(strconv.Itoa(i), -1)
+		b.Put(strconv.Itoa(i), i)
+	}
+	assert.Equal(t, a.Summary(), b.Summary())
+}
+
+func TestMerkleRemove(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	}
+	m := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	m.Put("a", 1)
+	summary := m.Summary()
+
+	m.Put("x", 1)
+	assert.NotEqual(t, summary, m.Summary())
+	m.Remove("x")
+	assert.Equal(t, summary, m.Summary())
+	m.Remove("missing")
+	assert.Equal(t, summary, m.Summary())
+
+	m.Remove("a")
+	assert.Equal(t, New(treemap.NewWithComparator[string, int](utils.StringComparator), config).Root(), m.Root())
+}
+
+func TestMerkleNewMatchesIncremental(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	}
+	m := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	for i := 0; i < 100; i++ {
+		m.Put(strconv.Itoa(i), i)
+	}
+
+	rebuilt := New(m.Map(), config)
+	assert.Equal(t, m.Summary(), rebuilt.Summary())
+}
+
+func TestCompareSummariesEqual(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	for _, key := range []string{"1", "A", "a", "z"} {
+		a.Put(key, 1)
+		b.Put(key, 1)
+	}
+
+	ranges, err := CompareSummaries(a.Summary(), b.Summary())
+	assert.NoError(t, err)
+	assert.Empty(t, ranges)
+}
+
+func TestCompareSummariesDiffering(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	for _, key := range []string{"1", "A", "a", "z"} {
+		a.Put(key, 1)
+		b.Put(key, 1)
+	}
+	b.Put("A", 2)
+	b.Put("zz", 1)
+
+	ranges, err := CompareSummaries(a.Summary(), b.Summary())
+	assert.NoError(t, err)
+	bucket := StringBucket(64)
+	assert.Equal(t, []BucketRange{{First: bucket("A"), Last: bucket("A")}, {First: bucket("z"), Last: bucket("z")}}, ranges)
+	assert.Equal(t, []treemap.Entry[string, int]{{Key: "A", Value: 1}, {Key: "z", Value: 1}}, a.Entries(ranges))
+	assert.Equal(t, []treemap.Entry[string, int]{{Key: "A", Value: 2}, {Key: "z", Value: 1}, {Key: "zz", Value: 1}}, b.Entries(ranges))
+}
+
+func TestMerkleEntriesSeeksToBuckets(t *testing.T) {
+	bucket := StringBucket(64)
+	calls := 0
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+		Bucket: func(key string) uint64 {
+			calls++
+			return bucket(key)
+		},
+	}
+	m := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	for i := 0; i < 256; i++ {
+		m.Put(string([]byte{byte(i)}), i)
+	}
+
+	calls = 0
+	entries := m.Entries([]BucketRange{{First: 10, Last: 11}, {First: 40, Last: 40}})
+	var keys []int
+	for _, entry := range entries {
+		keys = append(keys, entry.Value)
+	}
+	// every bucket holds four single-byte keys
+	assert.Equal(t, []int{40, 41, 42, 43, 44, 45, 46, 47, 160, 161, 162, 163}, keys)
+	// only the entries in the ranges and the first one past each range are bucketed
+	assert.Equal(t, len(entries)+2, calls)
+	assert.Empty(t, m.Entries(nil))
+}
+
+func TestStringBucketStart(t *testing.T) {
+	for _, buckets := range []uint64{1, 2, 3, 64, 1000} {
+		bucket, start := StringBucket(buckets), StringBucketStart(buckets)
+		assert.Equal(t, "", start(0))
+		for b := uint64(0); b < buckets; b++ {
+			key := start(b)
+			assert.Equal(t, b, bucket(key), "buckets %d, bucket %d", buckets, b)
+			if b > 0 && key != "" {
+				// the key right before the start falls into an earlier bucket
+				previous := []byte(key)
+				previous[len(previous)-1]--
+				assert.Less(t, bucket(string(previous)+"\xff\xff\xff\xff\xff\xff\xff\xff"), b)
+			}
+		}
+	}
+}
+
+func TestCompareSummariesShapeMismatch(t *testing.T) {
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	})
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), Config[string, int]{
+		Fanout: 2, Depth: 1, Bucket: StringBucket(2), BucketStart: StringBucketStart(2), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	})
+
+	_, err := CompareSummaries(a.Summary(), b.Summary())
+	assert.ErrorIs(t, err, ErrShapeMismatch)
+}
+
+func TestReconcile(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+		KeyCodec: utils.StringCodec{}, ValueCodec: utils.IntCodec{},
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	for i := 0; i < 200; i++ {
+		a.Put(strconv.Itoa(i), i)
+		b.Put(strconv.Itoa(i), i)
+	}
+	a.Put("a-only", 1)
+	b.Put("b-only", 2)
+
+	left, right := net.Pipe()
+	defer left.Close()
+	defer right.Close()
+	errs := make(chan error, 1)
+	go func() { errs <- b.Reconcile(right, maxResolver) }()
+	assert.NoError(t, a.Reconcile(left, maxResolver))
+	assert.NoError(t, <-errs)
+
+	assert.Equal(t, a.Summary(), b.Summary())
+	assert.Equal(t, a.Map().Keys(), b.Map().Keys())
+	assert.Equal(t, a.Map().Values(), b.Map().Values())
+	assert.Equal(t, 202, a.Size())
+}
+
+func TestReconcileResolvesConflicts(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+		KeyCodec: utils.StringCodec{}, ValueCodec: utils.IntCodec{},
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	a.Put("42", 100)
+	b.Put("42", 42)
+	a.Put("7", 7)
+	b.Put("7", 70)
+
+	left, right := net.Pipe()
+	defer left.Close()
+	defer right.Close()
+	errs := make(chan error, 1)
+	go func() { errs <- b.Reconcile(right, maxResolver) }()
+	assert.NoError(t, a.Reconcile(left, maxResolver))
+	assert.NoError(t, <-errs)
+
+	for _, m := range []*Map[string, int]{a, b} {
+		value, _ := m.Get("42")
+		assert.Equal(t, 100, value)
+		value, _ = m.Get("7")
+		assert.Equal(t, 70, value)
+	}
+}
+
+func TestReconcileInSync(t *testing.T) {
+	config := Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+		KeyCodec: utils.StringCodec{}, ValueCodec: utils.IntCodec{},
+	}
+	a := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	b := New(treemap.NewWithComparator[string, int](utils.StringComparator), config)
+	a.Put("a", 1)
+	b.Put("a", 1)
+
+	left, right := net.Pipe()
+	defer left.Close()
+	defer right.Close()
+	errs := make(chan error, 1)
+	go func() { errs <- b.Reconcile(right, maxResolver) }()
+	assert.NoError(t, a.Reconcile(left, maxResolver))
+	assert.NoError(t, <-errs)
+	assert.Equal(t, []string{"a"}, b.Map().Keys())
+}
+
+func TestReconcileMissingCodec(t *testing.T) {
+	m := New(treemap.NewWithComparator[string, int](utils.StringComparator), Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+	})
+
+	left, right := net.Pipe()
+	defer left.Close()
+	defer right.Close()
+	assert.ErrorIs(t, m.Reconcile(left, maxResolver), ErrMissingCodec)
+}
+
+func TestReconcilePeerClosesMidExchange(t *testing.T) {
+	m := New(treemap.NewWithComparator[string, int](utils.StringComparator), Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+		KeyCodec: utils.StringCodec{}, ValueCodec: utils.IntCodec{},
+	})
+	m.Put("a", 1)
+
+	left, right := net.Pipe()
+	defer left.Close()
+	go func() {
+		// answer the shape, then hang up before the hashes are exchanged
+		var shape shapeMessage
+		json.NewDecoder(right).Decode(&shape)
+		json.NewEncoder(right).Encode(shape)
+		right.Close()
+	}()
+
+	assert.Error(t, m.Reconcile(left, maxResolver))
+}
+
+// stalledStream serves canned input but blocks every write until it is closed.
+type stalledStream struct {
+	io.Reader
+	closed   chan struct{}
+	released chan struct{}
+}
+
+func (stream *stalledStream) Write(p []byte) (int, error) {
+	<-stream.closed
+	close(stream.released)
+	return 0, errors.New("stream closed")
+}
+
+func (stream *stalledStream) Close() error {
+	close(stream.closed)
+	return nil
+}
+
+func TestReconcileReleasesBlockedSend(t *testing.T) {
+	m := New(treemap.NewWithComparator[string, int](utils.StringComparator), Config[string, int]{
+		Fanout: 4, Depth: 3, Bucket: StringBucket(64), BucketStart: StringBucketStart(64), KeyHash: utils.StringHash, ValueHash: utils.IntegerHash[int],
+		KeyCodec: utils.StringCodec{}, ValueCodec: utils.IntCodec{},
+	})
+	stream := &stalledStream{Reader: strings.NewReader("}"), closed: make(chan struct{}), released: make(chan struct{})}
+
+	assert.Error(t, m.Reconcile(stream, maxResolver))
+	select {
+	case <-stream.released:
+	default:
+		t.Fatal("send still blocked after Reconcile returned")
+	}
+}
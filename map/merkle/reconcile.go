@@ -0,0 +1,127 @@
+package merkle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrMissingCodec is returned by Reconcile when the config has no key or value codec.
+var ErrMissingCodec = errors.New("merkle: reconcile needs key and value codecs")
+
+type shapeMessage struct {
+	Fanout int `json:"fanout"`
+	Depth  int `json:"depth"`
+}
+
+type entryMessage struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Reconcile brings the map and the peer map on the other end of the stream to the same contents.
+// Both sides call Reconcile at the same time; they walk down their summaries level by level,
+// exchanging only the hashes below differing nodes, then exchange the entries of the differing buckets.
+// Entries missing on one side are copied over, and keys present on both with different values
+// are set to resolve(key, local, remote), so resolve must give the same result on both sides
+// (e.g. pick the larger value) for the replicas to converge. Removals are not propagated.
+//
+// Both sides must use the same fanout, depth, bucket function, hashers and codecs.
+// On error, the stream is left in an undefined state. A stream implementing io.Closer is closed
+// when receiving fails, which also releases a send the peer stopped reading;
+// other streams should be closed by the caller.
+func (merkle *Map[K, V]) Reconcile(rw io.ReadWriter, resolve func(key K, local, remote V) V) error {
+	if merkle.config.KeyCodec == nil || merkle.config.ValueCodec == nil {
+		return ErrMissingCodec
+	}
+	encoder, decoder := json.NewEncoder(rw), json.NewDecoder(rw)
+
+	var peer shapeMessage
+	shape := shapeMessage{Fanout: merkle.config.Fanout, Depth: merkle.config.Depth}
+	if err := exchange(rw, encoder, decoder, shape, &peer); err != nil {
+		return err
+	}
+	if peer != shape {
+		return ErrShapeMismatch
+	}
+
+	frontier := []int{0}
+	for depth, level := range merkle.levels {
+		hashes := make([]uint64, len(frontier))
+		for i, index := range frontier {
+			hashes[i] = level[index]
+		}
+		var remote []uint64
+		if err := exchange(rw, encoder, decoder, hashes, &remote); err != nil {
+			return err
+		}
+		if len(remote) != len(hashes) {
+			return fmt.Errorf("merkle: peer sent %d hashes, expected %d", len(remote), len(hashes))
+		}
+		var nodes []int
+		for i, index := range frontier {
+			if hashes[i] != remote[i] {
+				nodes = append(nodes, index)
+			}
+		}
+		if len(nodes) == 0 {
+			return nil
+		}
+		if frontier = nodes; depth < merkle.config.Depth {
+			frontier = children(nodes, merkle.config.Fanout)
+		}
+	}
+
+	local := merkle.Entries(ranges(frontier))
+	messages := make([]entryMessage, len(local))
+	for i, entry := range local {
+		key, err := merkle.config.KeyCodec.Encode(entry.Key)
+		if err != nil {
+			return fmt.Errorf("merkle: encode key: %w", err)
+		}
+		value, err := merkle.config.ValueCodec.Encode(entry.Value)
+		if err != nil {
+			return fmt.Errorf("merkle: encode value: %w", err)
+		}
+		messages[i] = entryMessage{Key: key, Value: value}
+	}
+	var remote []entryMessage
+	if err := exchange(rw, encoder, decoder, messages, &remote); err != nil {
+		return err
+	}
+	for _, message := range remote {
+		key, err := merkle.config.KeyCodec.Decode(message.Key)
+		if err != nil {
+			return fmt.Errorf("merkle: decode key: %w", err)
+		}
+		value, err := merkle.config.ValueCodec.Decode(message.Value)
+		if err != nil {
+			return fmt.Errorf("merkle: decode value: %w", err)
+		}
+		if current, found := merkle.m.Get(key); found {
+			value = resolve(key, current, value)
+		}
+		merkle.Put(key, value)
+	}
+	return nil
+}
+
+// exchange sends the message while receiving the peer's,
+// so both sides can write first even on an unbuffered stream.
+func exchange(stream io.ReadWriter, encoder *json.Encoder, decoder *json.Decoder, message, peer interface{}) error {
+	sent := make(chan error, 1)
+	go func() { sent <- encoder.Encode(message) }()
+	if err := decoder.Decode(peer); err != nil {
+		// the send may be blocked on a peer that stopped reading, closing the stream releases it
+		if closer, ok := stream.(io.Closer); ok {
+			closer.Close()
+			<-sent
+		}
+		return fmt.Errorf("merkle: receive: %w", err)
+	}
+	if err := <-sent; err != nil {
+		return fmt.Errorf("merkle: send: %w", err)
+	}
+	return nil
+}
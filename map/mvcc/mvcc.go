@@ -0,0 +1,158 @@
+// Package mvcc provides a multi-version tree map with snapshot isolation.
+//
+// Every key holds a chain of versions, newest first. Writers stamp each change with a monotonically
+// increasing version, and snapshots read the map as of the version they were taken at,
+// so long scans see a consistent state without holding writers off: locks are only held
+// for a single lookup or step at a time.
+package mvcc
+
+import (
+	"sync"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Map is a multi-version tree map, safe for concurrent use.
+type Map[K any, V any] struct {
+	mu        sync.RWMutex
+	tree      *redblacktree.Tree[K, *version[V]]
+	version   uint64
+	snapshots *redblacktree.Tree[uint64, int] // live snapshot versions and their counts
+}
+
+// version is a single value of a key, or its removal, stamped with the version that wrote it.
+// Versions are immutable once linked, except for older, which the garbage collector cuts.
+type version[V any] struct {
+	stamp   uint64
+	value   V
+	deleted bool
+	older   *version[V]
+}
+
+// New instantiates a multi-version map with the custom comparator.
+func New[K any, V any](comparator utils.Comparator[K]) *Map[K, V] {
+	return &Map[K, V]{
+		tree:      redblacktree.NewWithComparator[K, *version[V]](comparator),
+		snapshots: redblacktree.NewWithComparator[uint64, int](utils.NumbersComparator[uint64]),
+	}
+}
+
+// Put inserts key-value pair into the map as a new version and returns that version.
+func (m *Map[K, V]) Put(key K, value V) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.version++
+	stamp := m.version
+	m.tree.Compute(key, func(head *version[V], _ bool) (*version[V], bool) {
+		return &version[V]{stamp: stamp, value: value, older: head}, true
+	})
+	return stamp
+}
+
+// Remove removes the element from the map by key as a new version and returns that version.
+// Second return parameter is true if key was present, otherwise false and no version is written.
+func (m *Map[K, V]) Remove(key K) (stamp uint64, removed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	head, found := m.tree.Get(key)
+	if !found || head.deleted {
+		return 0, false
+	}
+	m.version++
+	m.tree.Put(key, &version[V]{stamp: m.version, deleted: true, older: head})
+	return m.version, true
+}
+
+// Get searches the element in the latest version of the map by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if head, found := m.tree.Get(key); found && !head.deleted {
+		return head.value, true
+	}
+	return
+}
+
+// Version returns the latest version written to the map.
+func (m *Map[K, V]) Version() uint64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.version
+}
+
+// Snapshot returns a read view of the map at its latest version.
+// The snapshot must be closed once it is not needed anymore, so the garbage collector can prune its versions.
+func (m *Map[K, V]) Snapshot() *Snapshot[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count, _ := m.snapshots.Get(m.version)
+	m.snapshots.Put(m.version, count+1)
+	return &Snapshot[K, V]{m: m, version: m.version}
+}
+
+// GC prunes the versions no live snapshot can see anymore, keys whose latest version is a removal included,
+// and returns the number of pruned versions. Versions newer than the oldest live snapshot are always kept.
+// The map is locked for one key at a time, so writers interleave with the collection.
+func (m *Map[K, V]) GC() int {
+	pruned := 0
+	var key K
+	for first := true; ; first = false {
+		m.mu.Lock()
+		var node *redblacktree.Node[K, *version[V]]
+		if first {
+			node = m.tree.Left()
+		} else {
+			node, _ = m.tree.Higher(key)
+		}
+		if node == nil {
+			m.mu.Unlock()
+			return pruned
+		}
+		key = node.Key
+		pruned += m.prune(node)
+		m.mu.Unlock()
+	}
+}
+
+// prune cuts the versions of the node older than the one the oldest live snapshot sees,
+// and that one too if it is a removal. Must be called with the write lock held.
+func (m *Map[K, V]) prune(node *redblacktree.Node[K, *version[V]]) int {
+	oldest := m.version
+	if left := m.snapshots.Left(); left != nil {
+		oldest = left.Key
+	}
+	var newer *version[V]
+	visible := node.Value
+	for visible != nil && visible.stamp > oldest {
+		newer, visible = visible, visible.older
+	}
+	if visible == nil {
+		return 0
+	}
+	pruned := 0
+	for older := visible.older; older != nil; older = older.older {
+		pruned++
+	}
+	visible.older = nil
+	if visible.deleted {
+		pruned++
+		if newer == nil {
+			m.tree.Remove(node.Key)
+		} else {
+			newer.older = nil
+		}
+	}
+	return pruned
+}
+
+// visible returns the version of the chain seen at the given version, if any.
+func visible[V any](head *version[V], at uint64) (value V, found bool) {
+	for ; head != nil; head = head.older {
+		if head.stamp <= at {
+			return head.value, !head.deleted
+		}
+	}
+	return
+}
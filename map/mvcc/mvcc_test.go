@@ -0,0 +1,210 @@
+package mvcc
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMVCCSnapshot(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int])
+	assert.Equal(t, uint64(1), m.Put(1, "a"))
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	snapshot := m.Snapshot()
+	defer snapshot.Close()
+	assert.Equal(t, uint64(3), snapshot.Version())
+
+	m.Put(2, "B")
+	stamp, removed := m.Remove(3)
+	assert.Equal(t, uint64(5), stamp)
+	assert.True(t, removed)
+	_, removed = m.Remove(3)
+	assert.False(t, removed)
+	m.Put(4, "d")
+
+	assert.Equal(t, []int{1, 2, 3}, snapshot.Keys())
+	assert.Equal(t, []string{"a", "b", "c"}, snapshot.Values())
+	value, found := snapshot.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "b", value)
+	_, found = snapshot.Get(4)
+	assert.False(t, found)
+
+	value, _ = m.Get(2)
+	assert.Equal(t, "B", value)
+	_, found = m.Get(3)
+	assert.False(t, found)
+
+	latest := m.Snapshot()
+	assert.Equal(t, []int{1, 2, 4}, latest.Keys())
+	var reversed []int
+	it := latest.Iterator()
+	for ok := it.Last(); ok; ok = it.Prev() {
+		reversed = append(reversed, it.Key())
+	}
+	assert.Equal(t, []int{4, 2, 1}, reversed)
+	latest.Close()
+	latest.Close()
+	assert.Panics(t, func() { latest.Get(1) })
+	assert.Panics(t, func() { latest.Iterator().Next() })
+}
+
+func TestMVCCGC(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(1, "b")
+	m.Put(2, "x")
+	snapshot := m.Snapshot()
+	m.Put(1, "c")
+	m.Remove(2)
+	m.Put(3, "y")
+	m.Remove(3)
+
+	// the snapshot still sees 1=b and 2=x, so only 1=a is older than what it sees
+	assert.Equal(t, 1, m.GC())
+	assert.Equal(t, []string{"b", "x"}, snapshot.Values())
+
+	snapshot.Close()
+	// 1=b, 2=x, 3=y and the removals of 2 and 3 can go now
+	assert.Equal(t, 5, m.GC())
+	assert.Equal(t, 1, m.tree.Size())
+	assert.Equal(t, 0, m.GC())
+	value, _ := m.Get(1)
+	assert.Equal(t, "c", value)
+}
+
+func TestMVCCConcurrentScan(t *testing.T) {
+	m := New[int, int](utils.NumbersComparator[int])
+	for i := 0; i < 1000; i++ {
+		m.Put(i, 0)
+	}
+	snapshot := m.Snapshot()
+	defer snapshot.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for round := 1; round <= 5; round++ {
+			for i := 0; i < 1000; i++ {
+				if i%2 == 0 {
+					m.Put(i, round)
+				} else {
+					m.Remove(i)
+					m.Put(i+1000, round)
+				}
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 5; i++ {
+			m.GC()
+		}
+	}()
+	for scan := 0; scan < 5; scan++ {
+		count := 0
+		for it := snapshot.Iterator(); it.Next(); count++ {
+			assert.Equal(t, count, it.Key())
+			assert.Equal(t, 0, it.Value())
+		}
+		assert.Equal(t, 1000, count)
+	}
+	wg.Wait()
+}
+
+func TestMVCCGCWaitsForLastSnapshot(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	first := m.Snapshot()
+	second := m.Snapshot()
+	m.Put(1, "b")
+	newer := m.Snapshot()
+	m.Put(1, "c")
+
+	// two snapshots share the version seeing 1=a, closing one of them keeps it
+	first.Close()
+	assert.Equal(t, 0, m.GC())
+	value, _ := second.Get(1)
+	assert.Equal(t, "a", value)
+
+	// once the last of them closes, 1=a goes but 1=b stays for the newer snapshot
+	second.Close()
+	assert.Equal(t, 1, m.GC())
+	value, _ = newer.Get(1)
+	assert.Equal(t, "b", value)
+	assert.Equal(t, 0, m.GC())
+
+	newer.Close()
+	assert.Equal(t, 1, m.GC())
+	value, _ = m.Get(1)
+	assert.Equal(t, "c", value)
+}
+
+func TestMVCCConcurrentWriters(t *testing.T) {
+	const writers, keys, rounds = 4, 50, 40
+	m := New[int, int](utils.NumbersComparator[int])
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for round := 1; round <= rounds; round++ {
+				for i := w * keys; i < (w+1)*keys; i++ {
+					if i%3 == 0 {
+						m.Remove(i)
+					}
+					m.Put(i, round)
+				}
+			}
+		}(w)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < rounds; i++ {
+			m.GC()
+		}
+	}()
+
+	scan := func(snapshot *Snapshot[int, int]) map[int]int {
+		values := make(map[int]int)
+		for it := snapshot.Iterator(); it.Next(); {
+			values[it.Key()] = it.Value()
+		}
+		return values
+	}
+	previous := map[int]int{}
+	for i := 0; i < 20; i++ {
+		snapshot := m.Snapshot()
+		values := scan(snapshot)
+		// a snapshot reads the same state however often it is read while writers go on
+		assert.Equal(t, values, scan(snapshot))
+		for key, value := range values {
+			got, found := snapshot.Get(key)
+			assert.True(t, found)
+			assert.Equal(t, value, got)
+		}
+		// values only grow, so a later snapshot never sees an older value of a key
+		for key, value := range previous {
+			if current, found := values[key]; found {
+				assert.GreaterOrEqual(t, current, value)
+			}
+		}
+		previous = values
+		snapshot.Close()
+	}
+	wg.Wait()
+
+	final := m.Snapshot()
+	defer final.Close()
+	assert.Equal(t, writers*keys, final.Size())
+	for _, value := range final.Values() {
+		assert.Equal(t, rounds, value)
+	}
+}
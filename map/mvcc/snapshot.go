@@ -0,0 +1,196 @@
+package mvcc
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// Snapshot is a read view of a map at a fixed version, safe for concurrent use.
+type Snapshot[K any, V any] struct {
+	m       *Map[K, V]
+	version uint64
+	closed  bool
+}
+
+// Version returns the version of the map the snapshot reads.
+func (snapshot *Snapshot[K, V]) Version() uint64 {
+	return snapshot.version
+}
+
+// Get searches the element in the snapshot by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+// Panics if the snapshot is closed.
+func (snapshot *Snapshot[K, V]) Get(key K) (value V, found bool) {
+	m := snapshot.m
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	snapshot.checkOpen()
+	if head, found := m.tree.Get(key); found {
+		return visible(head, snapshot.version)
+	}
+	return
+}
+
+// Keys returns all keys of the snapshot in-order.
+func (snapshot *Snapshot[K, V]) Keys() []K {
+	var keys []K
+	for it := snapshot.Iterator(); it.Next(); {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns all values of the snapshot in-order based on the key.
+func (snapshot *Snapshot[K, V]) Values() []V {
+	var values []V
+	for it := snapshot.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Size returns the number of elements of the snapshot in O(n).
+func (snapshot *Snapshot[K, V]) Size() int {
+	size := 0
+	for it := snapshot.Iterator(); it.Next(); {
+		size++
+	}
+	return size
+}
+
+// Close releases the snapshot, letting the garbage collector prune the versions only it could see.
+// Closing a closed snapshot does nothing.
+func (snapshot *Snapshot[K, V]) Close() {
+	m := snapshot.m
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if snapshot.closed {
+		return
+	}
+	snapshot.closed = true
+	if count, _ := m.snapshots.Get(snapshot.version); count > 1 {
+		m.snapshots.Put(snapshot.version, count-1)
+	} else {
+		m.snapshots.Remove(snapshot.version)
+	}
+}
+
+func (snapshot *Snapshot[K, V]) checkOpen() {
+	if snapshot.closed {
+		panic("mvcc: snapshot is closed")
+	}
+}
+
+// Iterator iterates over the elements of a snapshot in key order.
+// Every step locks the map only for a single seek, so writers interleave with the iteration
+// while it keeps seeing the snapshot's version. Each step runs in O(log n) plus the versions it skips.
+type Iterator[K any, V any] struct {
+	snapshot *Snapshot[K, V]
+	key      K
+	value    V
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator over the elements of the snapshot.
+func (snapshot *Snapshot[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{snapshot: snapshot, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator. Panics if the snapshot is closed.
+func (iterator *Iterator[K, V]) Next() bool {
+	return iterator.step(true)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator. Panics if the snapshot is closed.
+func (iterator *Iterator[K, V]) Prev() bool {
+	return iterator.step(false)
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Key() K {
+	return iterator.key
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Value() V {
+	return iterator.value
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[K, V]) Begin() {
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[K, V]) End() {
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// step seeks the closest key in the direction whose chain is visible at the snapshot's version.
+func (iterator *Iterator[K, V]) step(forward bool) bool {
+	if forward && iterator.position == end || !forward && iterator.position == begin {
+		return false
+	}
+	snapshot := iterator.snapshot
+	m := snapshot.m
+	for {
+		m.mu.RLock()
+		if snapshot.closed {
+			m.mu.RUnlock()
+			snapshot.checkOpen()
+		}
+		var node *redblacktree.Node[K, *version[V]]
+		switch {
+		case iterator.position != between && forward:
+			node = m.tree.Left()
+		case iterator.position != between:
+			node = m.tree.Right()
+		case forward:
+			node, _ = m.tree.Higher(iterator.key)
+		default:
+			node, _ = m.tree.Lower(iterator.key)
+		}
+		if node == nil {
+			m.mu.RUnlock()
+			if forward {
+				iterator.position = end
+			} else {
+				iterator.position = begin
+			}
+			return false
+		}
+		value, found := visible(node.Value, snapshot.version)
+		iterator.key, iterator.position = node.Key, between
+		m.mu.RUnlock()
+		if found {
+			iterator.value = value
+			return true
+		}
+	}
+}
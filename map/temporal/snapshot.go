@@ -0,0 +1,143 @@
+package temporal
+
+import (
+	"time"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+)
+
+// Snapshot is a lazily evaluated view of a temporal map as of a point in time.
+type Snapshot[K any, V any] struct {
+	m  *Map[K, V]
+	at time.Time
+}
+
+// Time returns the point in time the snapshot views.
+func (snapshot *Snapshot[K, V]) Time() time.Time {
+	return snapshot.at
+}
+
+// Get returns the value the key had at the snapshot's time or zero value if key was absent then.
+// Second return parameter is true if key was found, otherwise false.
+func (snapshot *Snapshot[K, V]) Get(key K) (value V, found bool) {
+	return snapshot.m.GetAsOf(key, snapshot.at)
+}
+
+// Keys returns the keys present at the snapshot's time in-order.
+func (snapshot *Snapshot[K, V]) Keys() []K {
+	var keys []K
+	for it := snapshot.Iterator(); it.Next(); {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns the values at the snapshot's time in-order based on the key.
+func (snapshot *Snapshot[K, V]) Values() []V {
+	var values []V
+	for it := snapshot.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Size returns the number of keys present at the snapshot's time in O(n log h), h being the longest history.
+func (snapshot *Snapshot[K, V]) Size() int {
+	size := 0
+	for it := snapshot.Iterator(); it.Next(); {
+		size++
+	}
+	return size
+}
+
+// Each calls the given function once for each key present at the snapshot's time, passing its key and value.
+func (snapshot *Snapshot[K, V]) Each(f func(key K, value V)) {
+	for it := snapshot.Iterator(); it.Next(); {
+		f(it.Key(), it.Value())
+	}
+}
+
+// Iterator iterates over the keys present at a snapshot's time, in key order.
+type Iterator[K any, V any] struct {
+	iterator treemap.Iterator[K, *treemap.Map[time.Time, Version[V]]]
+	at       time.Time
+	value    V
+}
+
+// Iterator returns a stateful iterator over the keys present at the snapshot's time and their values then.
+func (snapshot *Snapshot[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{iterator: snapshot.m.keys.Iterator(), at: snapshot.at}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Next() bool {
+	for iterator.iterator.Next() {
+		if iterator.settle() {
+			return true
+		}
+	}
+	return false
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Prev() bool {
+	for iterator.iterator.Prev() {
+		if iterator.settle() {
+			return true
+		}
+	}
+	return false
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Key() K {
+	return iterator.iterator.Key()
+}
+
+// Value returns the current element's value as of the snapshot's time.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Value() V {
+	return iterator.value
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[K, V]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[K, V]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// settle resolves the value of the current key at the snapshot's time and returns true if the key was present then.
+func (iterator *Iterator[K, V]) settle() bool {
+	version, found := iterator.iterator.Value().FloorEntry(iterator.at)
+	if !found || version.Value.Removed {
+		return false
+	}
+	iterator.value = version.Value.Value
+	return true
+}
@@ -0,0 +1,124 @@
+// Package temporal provides a tree map that records every value of each key with the time it became valid,
+// answering what the map held at any point in time.
+package temporal
+
+import (
+	"time"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Map is a tree map of keys to their histories, each a tree map of valid-from times to values.
+type Map[K any, V any] struct {
+	keys *treemap.Map[K, *treemap.Map[time.Time, Version[V]]]
+}
+
+// Version is a value of a key, or its removal, valid from a point in time until the next version.
+type Version[V any] struct {
+	ValidFrom time.Time
+	Value     V
+	Removed   bool
+}
+
+// New instantiates a temporal map with the custom key comparator.
+func New[K any, V any](comparator utils.Comparator[K]) *Map[K, V] {
+	return &Map[K, V]{keys: treemap.NewWithComparator[K, *treemap.Map[time.Time, Version[V]]](comparator)}
+}
+
+// Put records the value of the key valid from the given time, replacing a version valid from the same time.
+func (m *Map[K, V]) Put(key K, value V, validFrom time.Time) {
+	m.history(key).Put(validFrom, Version[V]{ValidFrom: validFrom, Value: value})
+}
+
+// Remove records the removal of the key from the given time on.
+func (m *Map[K, V]) Remove(key K, validFrom time.Time) {
+	m.history(key).Put(validFrom, Version[V]{ValidFrom: validFrom, Removed: true})
+}
+
+// Get returns the latest value of the key or zero value if key is absent.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	history, found := m.keys.Get(key)
+	if !found {
+		return
+	}
+	if latest, found := history.MaxEntry(); found && !latest.Value.Removed {
+		return latest.Value.Value, true
+	}
+	return value, false
+}
+
+// GetAsOf returns the value the key had at time t or zero value if key was absent then.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) GetAsOf(key K, t time.Time) (value V, found bool) {
+	history, found := m.keys.Get(key)
+	if !found {
+		return
+	}
+	if version, found := history.FloorEntry(t); found && !version.Value.Removed {
+		return version.Value.Value, true
+	}
+	return value, false
+}
+
+// History returns the recorded versions of the key, oldest first.
+func (m *Map[K, V]) History(key K) []Version[V] {
+	if history, found := m.keys.Get(key); found {
+		return history.Values()
+	}
+	return nil
+}
+
+// Keys returns every key with a recorded history in-order, including the ones currently removed.
+func (m *Map[K, V]) Keys() []K {
+	return m.keys.Keys()
+}
+
+// SnapshotAsOf returns a view of the map as it was at time t.
+// The view is evaluated lazily on every access, so it reflects later recorded versions valid at t.
+func (m *Map[K, V]) SnapshotAsOf(t time.Time) *Snapshot[K, V] {
+	return &Snapshot[K, V]{m: m, at: t}
+}
+
+// Compact drops the versions superseded before the retention horizon and returns the number of dropped versions.
+// The version valid at the horizon is kept, unless it is a removal, so queries as of the horizon or later
+// are answered as before, while earlier ones only see what remains.
+func (m *Map[K, V]) Compact(horizon time.Time) int {
+	dropped := 0
+	var emptied []K
+	for it := m.keys.Iterator(); it.Next(); {
+		history := it.Value()
+		floor, found := history.FloorEntry(horizon)
+		if !found {
+			continue
+		}
+		for {
+			oldest, _ := history.MinEntry()
+			if utils.TimeComparator(oldest.Key, floor.Key) >= 0 {
+				break
+			}
+			history.PollFirst()
+			dropped++
+		}
+		if floor.Value.Removed {
+			history.PollFirst()
+			dropped++
+		}
+		if history.Empty() {
+			emptied = append(emptied, it.Key())
+		}
+	}
+	for _, key := range emptied {
+		m.keys.Remove(key)
+	}
+	return dropped
+}
+
+// history returns the history of the key, creating it if needed.
+func (m *Map[K, V]) history(key K) *treemap.Map[time.Time, Version[V]] {
+	history, _ := m.keys.GetOrPut(key, func() *treemap.Map[time.Time, Version[V]] {
+		return treemap.NewWithComparator[time.Time, Version[V]](utils.TimeComparator)
+	})
+	return history
+}
@@ -0,0 +1,118 @@
+package temporal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func at(day int) time.Time {
+	return time.Date(2024, time.January, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTemporalGetAsOf(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("a", 1, at(1))
+	m.Put("a", 2, at(5))
+
+	_, found := m.GetAsOf("a", at(0))
+	assert.False(t, found)
+	value, found := m.GetAsOf("a", at(4))
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+	value, _ = m.GetAsOf("a", at(5))
+	assert.Equal(t, 2, value)
+	_, found = m.GetAsOf("z", at(5))
+	assert.False(t, found)
+}
+
+func TestTemporalGet(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("a", 1, at(1))
+	m.Put("a", 2, at(5))
+
+	value, found := m.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+	_, found = m.Get("z")
+	assert.False(t, found)
+}
+
+func TestTemporalRemove(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("b", 10, at(3))
+	m.Remove("b", at(6))
+
+	value, found := m.GetAsOf("b", at(5))
+	assert.True(t, found)
+	assert.Equal(t, 10, value)
+	_, found = m.GetAsOf("b", at(6))
+	assert.False(t, found)
+	_, found = m.Get("b")
+	assert.False(t, found)
+}
+
+func TestTemporalHistory(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Remove("b", at(6))
+	m.Put("b", 10, at(3))
+
+	assert.Equal(t, []Version[int]{
+		{ValidFrom: at(3), Value: 10},
+		{ValidFrom: at(6), Removed: true},
+	}, m.History("b"))
+	assert.Nil(t, m.History("z"))
+}
+
+func TestTemporalSnapshotAsOf(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("a", 1, at(1))
+	m.Put("a", 2, at(5))
+	m.Put("b", 10, at(3))
+	m.Remove("b", at(6))
+	m.Put("c", 100, at(4))
+
+	snapshot := m.SnapshotAsOf(at(4))
+	assert.Equal(t, []string{"a", "b", "c"}, snapshot.Keys())
+	assert.Equal(t, []int{1, 10, 100}, snapshot.Values())
+	assert.Equal(t, []string{"a", "c"}, m.SnapshotAsOf(at(6)).Keys())
+	assert.Equal(t, 0, m.SnapshotAsOf(at(0)).Size())
+}
+
+func TestTemporalSnapshotIsLazy(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("a", 1, at(1))
+	m.Put("c", 100, at(4))
+	snapshot := m.SnapshotAsOf(at(4))
+
+	// the snapshot sees versions recorded after it was taken
+	m.Put("b", 7, at(2))
+	assert.Equal(t, 3, snapshot.Size())
+	var reversed []string
+	it := snapshot.Iterator()
+	for ok := it.Last(); ok; ok = it.Prev() {
+		reversed = append(reversed, it.Key())
+	}
+	assert.Equal(t, []string{"c", "b", "a"}, reversed)
+}
+
+func TestTemporalCompact(t *testing.T) {
+	m := New[string, int](utils.StringComparator)
+	m.Put("a", 1, at(1))
+	m.Put("a", 2, at(5))
+	m.Put("a", 3, at(8))
+	m.Put("b", 10, at(3))
+	m.Remove("b", at(6))
+	m.Put("c", 100, at(4))
+	assert.Equal(t, 0, m.Compact(at(0)))
+
+	// a keeps its version of day 5, b is removed since day 6 and goes away entirely
+	assert.Equal(t, 3, m.Compact(at(7)))
+	assert.Equal(t, []string{"a", "c"}, m.Keys())
+	assert.Equal(t, []Version[int]{{ValidFrom: at(5), Value: 2}, {ValidFrom: at(8), Value: 3}}, m.History("a"))
+	value, _ := m.GetAsOf("a", at(7))
+	assert.Equal(t, 2, value)
+	assert.Equal(t, []int{2, 100}, m.SnapshotAsOf(at(7)).Values())
+}
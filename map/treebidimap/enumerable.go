@@ -0,0 +1,85 @@
+package treebidimap
+
+import "github.com/mikekonan/gods-generic/map/treemap"
+
+// Each calls the given function once for each element, passing that element's key and value.
+func (m *Map[K, V]) Each(f func(key K, value V)) {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		f(iterator.Key(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+// Pairs are put in key order, so a later pair displaces an earlier one sharing its key or its value.
+func (m *Map[K, V]) Map(f func(key1 K, value1 V) (K, V)) *Map[K, V] {
+	newMap := m.empty()
+	iterator := m.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		newMap.Put(key2, value2)
+	}
+	return newMap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (m *Map[K, V]) Select(f func(key K, value V) bool) *Map[K, V] {
+	newMap := m.empty()
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			newMap.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return newMap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (m *Map[K, V]) Any(f func(key K, value V) bool) bool {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (m *Map[K, V]) All(f func(key K, value V) bool) bool {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Key(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or zero values otherwise if no element
+// matches the criteria. Use FindEntry to tell that apart from a matching zero key.
+func (m *Map[K, V]) Find(f func(key K, value V) bool) (k K, v V) {
+	entry, _ := m.FindEntry(f)
+	return entry.Key, entry.Value
+}
+
+// FindEntry passes each element of the container to the given function and returns
+// the first entry for which the function is true.
+// Second return parameter is true if an element matches the criteria, otherwise false.
+func (m *Map[K, V]) FindEntry(f func(key K, value V) bool) (entry treemap.Entry[K, V], found bool) {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return treemap.Entry[K, V]{Key: iterator.Key(), Value: iterator.Value()}, true
+		}
+	}
+	return
+}
+
+func (m *Map[K, V]) empty() *Map[K, V] {
+	return New[K, V](m.forward.Comparator, m.inverse.Comparator)
+}
@@ -0,0 +1,184 @@
+// Package treebidimap provides a bidirectional map ordered both by its keys and by its values.
+//
+// The mapping is one-to-one: it is held in two red-black trees, one from keys to values ordered by the key comparator
+// and one from values to keys ordered by the value comparator, so lookups and ordered queries run in O(log n) both ways.
+package treebidimap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Map holds the elements in two red-black trees, one per direction.
+type Map[K any, V any] struct {
+	forward *redblacktree.Tree[K, V]
+	inverse *redblacktree.Tree[V, K]
+}
+
+// New instantiates a bidirectional map with the custom key and value comparators.
+func New[K any, V any](keyComparator utils.Comparator[K], valueComparator utils.Comparator[V]) *Map[K, V] {
+	return &Map[K, V]{
+		forward: redblacktree.NewWithComparator[K, V](keyComparator),
+		inverse: redblacktree.NewWithComparator[V, K](valueComparator),
+	}
+}
+
+// Inverse returns the map from values to keys. It shares its trees with this map,
+// so changes of either are reflected in the other.
+func (m *Map[K, V]) Inverse() *Map[V, K] {
+	return &Map[V, K]{forward: m.inverse, inverse: m.forward}
+}
+
+// Put inserts key-value pair into the map.
+// Both the previous value of the key and the previous key of the value are dropped, keeping the mapping one-to-one.
+// Key and value should adhere to their comparators' type assertions, otherwise method panics.
+func (m *Map[K, V]) Put(key K, value V) {
+	if previous, found := m.forward.Get(key); found {
+		m.inverse.Remove(previous)
+	}
+	if previous, found := m.inverse.Get(value); found {
+		m.forward.Remove(previous)
+	}
+	m.forward.Put(key, value)
+	m.inverse.Put(value, key)
+}
+
+// Get searches the element in the map by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	return m.forward.Get(key)
+}
+
+// GetKey searches the element in the map by value and returns its key or zero value if value is not found.
+// Second return parameter is true if value was found, otherwise false.
+// Value should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) GetKey(value V) (key K, found bool) {
+	return m.inverse.Get(value)
+}
+
+// Remove removes the element from the map by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Remove(key K) {
+	if value, found := m.forward.Get(key); found {
+		m.forward.Remove(key)
+		m.inverse.Remove(value)
+	}
+}
+
+// RemoveValue removes the element from the map by value.
+// Value should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) RemoveValue(value V) {
+	m.Inverse().Remove(value)
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return m.forward.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return m.forward.Size()
+}
+
+// Keys returns all keys in-order
+func (m *Map[K, V]) Keys() []K {
+	return m.forward.Keys()
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[K, V]) Values() []V {
+	return m.forward.Values()
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, V]) Clear() {
+	m.forward.Clear()
+	m.inverse.Clear()
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns zero values if map is empty.
+func (m *Map[K, V]) Min() (key K, value V) {
+	entry, _ := m.MinEntry()
+	return entry.Key, entry.Value
+}
+
+// MinEntry returns the entry with the minimum key.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) MinEntry() (entry treemap.Entry[K, V], found bool) {
+	return entryOf(m.forward.Left())
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns zero values if map is empty.
+func (m *Map[K, V]) Max() (key K, value V) {
+	entry, _ := m.MaxEntry()
+	return entry.Key, entry.Value
+}
+
+// MaxEntry returns the entry with the maximum key.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) MaxEntry() (entry treemap.Entry[K, V], found bool) {
+	return entryOf(m.forward.Right())
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be zero values.
+// Use FloorEntry to tell a missing floor apart from a zero key, and Inverse().Floor to search by value.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Floor(key K) (foundkey K, foundvalue V) {
+	entry, _ := m.FloorEntry(key)
+	return entry.Key, entry.Value
+}
+
+// FloorEntry finds the entry with the largest key smaller than or equal to the input key.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) FloorEntry(key K) (entry treemap.Entry[K, V], found bool) {
+	node, _ := m.forward.Floor(key)
+	return entryOf(node)
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be zero values.
+// Use CeilingEntry to tell a missing ceiling apart from a zero key, and Inverse().Ceiling to search by value.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Ceiling(key K) (foundkey K, foundvalue V) {
+	entry, _ := m.CeilingEntry(key)
+	return entry.Key, entry.Value
+}
+
+// CeilingEntry finds the entry with the smallest key larger than or equal to the input key.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) CeilingEntry(key K) (entry treemap.Entry[K, V], found bool) {
+	node, _ := m.forward.Ceiling(key)
+	return entryOf(node)
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "TreeBidiMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+func entryOf[K any, V any](node *redblacktree.Node[K, V]) (entry treemap.Entry[K, V], found bool) {
+	if node == nil {
+		return entry, false
+	}
+	return treemap.Entry[K, V]{Key: node.Key, Value: node.Value}, true
+}
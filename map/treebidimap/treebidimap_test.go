@@ -0,0 +1,193 @@
+package treebidimap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeBidiMapPut(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+	assert.Equal(t, []string{"a", "b", "c"}, m.Values())
+	value, found := m.Get(2)
+	assert.Equal(t, "b", value)
+	assert.True(t, found)
+	key, found := m.GetKey("c")
+	assert.Equal(t, 3, key)
+	assert.True(t, found)
+	_, found = m.GetKey("z")
+	assert.False(t, found)
+}
+
+func TestTreeBidiMapPutReplacesValue(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(1, "x")
+
+	// a new value for a key drops the stale reverse entry
+	m.Put(1, "a")
+	_, found := m.GetKey("x")
+	assert.False(t, found)
+	key, _ := m.GetKey("a")
+	assert.Equal(t, 1, key)
+	assert.Equal(t, 1, m.Inverse().Size())
+}
+
+func TestTreeBidiMapPutMovesValue(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	// a value moving to another key drops the old key
+	m.Put(4, "b")
+	_, found := m.Get(2)
+	assert.False(t, found)
+	assert.Equal(t, []int{1, 4}, m.Keys())
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, 2, m.Inverse().Size())
+}
+
+func TestTreeBidiMapRemove(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	m.Remove(1)
+	m.RemoveValue("c")
+	m.Remove(42)
+	m.RemoveValue("z")
+	assert.Equal(t, []int{2}, m.Keys())
+	assert.Equal(t, []string{"b"}, m.Inverse().Keys())
+	assert.Equal(t, "TreeBidiMap\nmap[2:b]", m.String())
+}
+
+func TestTreeBidiMapClear(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+
+	m.Clear()
+	assert.True(t, m.Empty())
+	assert.True(t, m.Inverse().Empty())
+}
+
+func TestTreeBidiMapInverse(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(2, "b")
+
+	inverse := m.Inverse()
+	assert.Equal(t, []string{"b", "c", "x"}, inverse.Keys())
+	assert.Equal(t, []int{2, 3, 1}, inverse.Values())
+
+	// writes through the inverse are seen by the map
+	inverse.Put("a", 3)
+	value, _ := m.Get(3)
+	assert.Equal(t, "a", value)
+	assert.Equal(t, []string{"a", "b", "x"}, inverse.Keys())
+}
+
+func TestTreeBidiMapNavigation(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "a")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	inverse := m.Inverse()
+
+	key, value := m.Floor(10)
+	assert.Equal(t, 3, key)
+	assert.Equal(t, "a", value)
+	_, found := m.CeilingEntry(10)
+	assert.False(t, found)
+	maxKey, maxValue := m.Max()
+	assert.Equal(t, 3, maxKey)
+	assert.Equal(t, "a", maxValue)
+
+	entry, found := inverse.FloorEntry("w")
+	assert.True(t, found)
+	assert.Equal(t, treemap.Entry[string, int]{Key: "b", Value: 2}, entry)
+	valueKey, _ := inverse.Ceiling("c")
+	assert.Equal(t, "x", valueKey)
+	minKey, _ := inverse.Min()
+	assert.Equal(t, "a", minKey)
+}
+
+func TestTreeBidiMapIterator(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(2, "b")
+
+	var keys []int
+	it := m.Iterator()
+	for ok := it.Last(); ok; ok = it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	assert.Equal(t, []int{3, 2, 1}, keys)
+}
+
+func TestTreeBidiMapMap(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(2, "b")
+
+	doubled := m.Map(func(key int, value string) (int, string) { return key * 2, value + value })
+	assert.Equal(t, []int{2, 4, 6}, doubled.Keys())
+	assert.Equal(t, []string{"xx", "bb", "cc"}, doubled.Values())
+
+	// values produced more than once keep only the last key
+	collapsed := m.Map(func(key int, value string) (int, string) { return key, "same" })
+	assert.Equal(t, []int{3}, collapsed.Keys())
+}
+
+func TestTreeBidiMapSelect(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(2, "b")
+
+	selected := m.Select(func(key int, _ string) bool { return key > 1 })
+	assert.Equal(t, []int{2, 3}, selected.Keys())
+	assert.Equal(t, []string{"b", "c"}, selected.Inverse().Keys())
+}
+
+func TestTreeBidiMapPredicates(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(2, "b")
+
+	assert.True(t, m.Any(func(_ int, value string) bool { return value == "x" }))
+	assert.False(t, m.Any(func(_ int, value string) bool { return value == "z" }))
+	assert.True(t, m.All(func(key int, _ string) bool { return key > 0 }))
+	assert.False(t, m.All(func(key int, _ string) bool { return key > 1 }))
+
+	count := 0
+	m.Each(func(_ int, _ string) { count++ })
+	assert.Equal(t, 3, count)
+}
+
+func TestTreeBidiMapFind(t *testing.T) {
+	m := New[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(2, "b")
+
+	key, value := m.Find(func(key int, _ string) bool { return key > 1 })
+	assert.Equal(t, 2, key)
+	assert.Equal(t, "b", value)
+	entry, found := m.FindEntry(func(_ int, value string) bool { return value == "c" })
+	assert.Equal(t, treemap.Entry[int, string]{Key: 3, Value: "c"}, entry)
+	assert.True(t, found)
+	_, found = m.FindEntry(func(key int, _ string) bool { return key > 5 })
+	assert.False(t, found)
+}
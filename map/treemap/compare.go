@@ -0,0 +1,59 @@
+package treemap
+
+import "github.com/mikekonan/gods-generic/utils"
+
+// Equal returns true if both maps contain the same keys, comparing their values with valueEqual.
+// Panics if the maps use different comparators.
+func (m *Map[K, V]) Equal(other *Map[K, V], valueEqual func(a, b V) bool) bool {
+	if !utils.SameComparator(m.tree.Comparator, other.tree.Comparator) {
+		panic("treemap: maps use different comparators")
+	}
+	if m.Size() != other.Size() {
+		return false
+	}
+	comparator := m.tree.Comparator
+	a, b := m.tree.Iterator(), other.tree.Iterator()
+	for a.Next() && b.Next() {
+		if comparator(a.Key(), b.Key()) != 0 || !valueEqual(a.Value(), b.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of the map combining keyHash and valueHash of every entry in-order,
+// so maps that are Equal hash the same as long as the hashers agree with the comparators.
+func (m *Map[K, V]) Hash(keyHash func(K) uint64, valueHash func(V) uint64) uint64 {
+	hash := utils.HashSeed
+	for it := m.tree.Iterator(); it.Next(); {
+		hash = utils.HashCombine(hash, keyHash(it.Key()))
+		hash = utils.HashCombine(hash, valueHash(it.Value()))
+	}
+	return hash
+}
+
+// Comparator returns a comparator ordering maps lexicographically by their entries in-order,
+// comparing keys with keyComparator and then values with valueComparator.
+// A map that is a prefix of another comes first.
+func Comparator[K any, V any](keyComparator utils.Comparator[K], valueComparator utils.Comparator[V]) utils.Comparator[*Map[K, V]] {
+	return func(a, b *Map[K, V]) int {
+		itA, itB := a.tree.Iterator(), b.tree.Iterator()
+		for {
+			okA, okB := itA.Next(), itB.Next()
+			switch {
+			case !okA && !okB:
+				return 0
+			case !okA:
+				return -1
+			case !okB:
+				return 1
+			}
+			if compare := keyComparator(itA.Key(), itB.Key()); compare != 0 {
+				return compare
+			}
+			if compare := valueComparator(itA.Value(), itB.Value()); compare != 0 {
+				return compare
+			}
+		}
+	}
+}
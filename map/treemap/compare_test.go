@@ -0,0 +1,79 @@
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapEqual(t *testing.T) {
+	a := NewWithComparator[int, string](utils.NumbersComparator[int])
+	a.Put(1, "a")
+	a.Put(2, "b")
+	b := NewWithComparator[int, string](utils.NumbersComparator[int])
+	b.Put(2, "b")
+	b.Put(1, "a")
+
+	assert.True(t, a.Equal(b, stringEqual))
+	b.Put(2, "x")
+	assert.False(t, a.Equal(b, stringEqual))
+	b.Put(2, "b")
+	b.Put(3, "c")
+	assert.False(t, a.Equal(b, stringEqual))
+	b.Remove(1)
+	assert.False(t, a.Equal(b, stringEqual))
+
+	other := NewWithComparator[int, string](func(x, y int) int { return y - x })
+	assert.PanicsWithValue(t, "treemap: maps use different comparators", func() { a.Equal(other, stringEqual) })
+}
+
+func TestMapHash(t *testing.T) {
+	a := NewWithComparator[int, string](utils.NumbersComparator[int])
+	a.Put(1, "a")
+	a.Put(2, "b")
+	a.Put(3, "c")
+	b := NewWithComparator[int, string](utils.NumbersComparator[int])
+	b.Put(3, "c")
+	b.Put(1, "a")
+	b.Put(2, "b")
+
+	assert.Equal(t, a.Hash(utils.IntegerHash[int], utils.StringHash), b.Hash(utils.IntegerHash[int], utils.StringHash))
+	b.Put(2, "x")
+	assert.NotEqual(t, a.Hash(utils.IntegerHash[int], utils.StringHash), b.Hash(utils.IntegerHash[int], utils.StringHash))
+
+	// keys and values are not interchangeable
+	c := NewWithComparator[int, int](utils.NumbersComparator[int])
+	c.Put(1, 2)
+	d := NewWithComparator[int, int](utils.NumbersComparator[int])
+	d.Put(2, 1)
+	assert.NotEqual(t, c.Hash(utils.IntegerHash[int], utils.IntegerHash[int]), d.Hash(utils.IntegerHash[int], utils.IntegerHash[int]))
+}
+
+func TestMapComparator(t *testing.T) {
+	comparator := Comparator[int, string](utils.NumbersComparator[int], utils.StringComparator)
+	newMap := func(entries ...Entry[int, string]) *Map[int, string] {
+		m := NewWithComparator[int, string](utils.NumbersComparator[int])
+		for _, entry := range entries {
+			m.Put(entry.Key, entry.Value)
+		}
+		return m
+	}
+
+	tests := []struct {
+		a, b     *Map[int, string]
+		expected int
+	}{
+		{newMap(), newMap(), 0},
+		{newMap(), newMap(Entry[int, string]{1, "a"}), -1},
+		{newMap(Entry[int, string]{1, "a"}), newMap(Entry[int, string]{1, "a"}), 0},
+		{newMap(Entry[int, string]{1, "a"}), newMap(Entry[int, string]{1, "a"}, Entry[int, string]{2, "b"}), -1},
+		{newMap(Entry[int, string]{1, "b"}), newMap(Entry[int, string]{1, "a"}, Entry[int, string]{2, "b"}), 1},
+		{newMap(Entry[int, string]{2, "a"}), newMap(Entry[int, string]{1, "z"}), 1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, comparator(test.a, test.b))
+		assert.Equal(t, -test.expected, comparator(test.b, test.a))
+	}
+}
@@ -0,0 +1,90 @@
+package treemap
+
+import "fmt"
+
+// Change is a key whose value differs between two versions of a map.
+type Change[K any, V any] struct {
+	Key K `json:"key"`
+	Old V `json:"old"`
+	New V `json:"new"`
+}
+
+// Patch holds the differences between two versions of a map, every list in-order.
+// It can be serialized as long as its keys and values can.
+type Patch[K any, V any] struct {
+	Added   []Entry[K, V]  `json:"added,omitempty"`
+	Removed []Entry[K, V]  `json:"removed,omitempty"`
+	Changed []Change[K, V] `json:"changed,omitempty"`
+}
+
+// Empty returns true if the patch holds no differences.
+func (patch Patch[K, V]) Empty() bool {
+	return len(patch.Added) == 0 && len(patch.Removed) == 0 && len(patch.Changed) == 0
+}
+
+// ConflictError is returned by ApplyStrict when the map does not match the base of the patch.
+type ConflictError[K any] struct {
+	Key    K
+	Reason string
+}
+
+// Error returns the error message with the conflicting key.
+func (err *ConflictError[K]) Error() string {
+	return fmt.Sprintf("treemap: patch conflicts at key %v: %s", err.Key, err.Reason)
+}
+
+// Diff returns the patch turning the old map into the new one, comparing values with valueEqual.
+// Runs in O(m+n). Panics if the maps use different comparators.
+func Diff[K any, V any](old, new *Map[K, V], valueEqual func(a, b V) bool) Patch[K, V] {
+	var patch Patch[K, V]
+	Join(old, new, FullOuter, func(key K, pair Pair[V, V]) {
+		switch {
+		case !pair.HasRight:
+			patch.Removed = append(patch.Removed, Entry[K, V]{Key: key, Value: pair.Left})
+		case !pair.HasLeft:
+			patch.Added = append(patch.Added, Entry[K, V]{Key: key, Value: pair.Right})
+		case !valueEqual(pair.Left, pair.Right):
+			patch.Changed = append(patch.Changed, Change[K, V]{Key: key, Old: pair.Left, New: pair.Right})
+		}
+	})
+	return patch
+}
+
+// Apply applies the patch to the map, regardless of the current values of its keys.
+func (m *Map[K, V]) Apply(patch Patch[K, V]) {
+	for _, entry := range patch.Removed {
+		m.tree.Remove(entry.Key)
+	}
+	for _, entry := range patch.Added {
+		m.tree.Put(entry.Key, entry.Value)
+	}
+	for _, change := range patch.Changed {
+		m.tree.Put(change.Key, change.New)
+	}
+}
+
+// ApplyStrict applies the patch to the map only if the map matches the base the patch was made from,
+// comparing values with valueEqual. Otherwise it returns a *ConflictError and leaves the map unchanged.
+func (m *Map[K, V]) ApplyStrict(patch Patch[K, V], valueEqual func(a, b V) bool) error {
+	for _, entry := range patch.Removed {
+		if value, found := m.tree.Get(entry.Key); !found {
+			return &ConflictError[K]{Key: entry.Key, Reason: "removed key is missing"}
+		} else if !valueEqual(value, entry.Value) {
+			return &ConflictError[K]{Key: entry.Key, Reason: "removed value differs"}
+		}
+	}
+	for _, entry := range patch.Added {
+		if _, found := m.tree.Get(entry.Key); found {
+			return &ConflictError[K]{Key: entry.Key, Reason: "added key is present"}
+		}
+	}
+	for _, change := range patch.Changed {
+		if value, found := m.tree.Get(change.Key); !found {
+			return &ConflictError[K]{Key: change.Key, Reason: "changed key is missing"}
+		} else if !valueEqual(value, change.Old) {
+			return &ConflictError[K]{Key: change.Key, Reason: "changed value differs"}
+		}
+	}
+	m.Apply(patch)
+	return nil
+}
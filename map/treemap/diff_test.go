@@ -0,0 +1,141 @@
+package treemap
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringEqual(a, b string) bool {
+	return a == b
+}
+
+func TestMapDiff(t *testing.T) {
+	old := NewWithComparator[int, string](utils.NumbersComparator[int])
+	old.Put(1, "a")
+	old.Put(2, "b")
+	old.Put(3, "c")
+	new := NewWithComparator[int, string](utils.NumbersComparator[int])
+	new.Put(2, "B")
+	new.Put(3, "c")
+	new.Put(4, "d")
+
+	patch := Diff(old, new, stringEqual)
+	assert.Equal(t, []Entry[int, string]{{Key: 4, Value: "d"}}, patch.Added)
+	assert.Equal(t, []Entry[int, string]{{Key: 1, Value: "a"}}, patch.Removed)
+	assert.Equal(t, []Change[int, string]{{Key: 2, Old: "b", New: "B"}}, patch.Changed)
+	assert.False(t, patch.Empty())
+	assert.True(t, Diff(old, old, stringEqual).Empty())
+}
+
+func TestMapApply(t *testing.T) {
+	old := NewWithComparator[int, string](utils.NumbersComparator[int])
+	old.Put(1, "a")
+	old.Put(2, "b")
+	new := NewWithComparator[int, string](utils.NumbersComparator[int])
+	new.Put(2, "B")
+	new.Put(3, "c")
+
+	patch := Diff(old, new, stringEqual)
+	old.Apply(patch)
+	assert.Equal(t, []int{2, 3}, old.Keys())
+	assert.Equal(t, []string{"B", "c"}, old.Values())
+}
+
+func TestMapApplyDrifted(t *testing.T) {
+	old := NewWithComparator[int, string](utils.NumbersComparator[int])
+	old.Put(1, "a")
+	old.Put(2, "b")
+	new := NewWithComparator[int, string](utils.NumbersComparator[int])
+	new.Put(2, "B")
+	patch := Diff(old, new, stringEqual)
+
+	drifted := NewWithComparator[int, string](utils.NumbersComparator[int])
+	drifted.Put(2, "x")
+	drifted.Put(5, "e")
+
+	// Apply overwrites regardless of the drift
+	drifted.Apply(patch)
+	assert.Equal(t, []int{2, 5}, drifted.Keys())
+	assert.Equal(t, []string{"B", "e"}, drifted.Values())
+}
+
+func TestMapApplyStrict(t *testing.T) {
+	old := NewWithComparator[int, string](utils.NumbersComparator[int])
+	old.Put(1, "a")
+	old.Put(2, "b")
+	new := NewWithComparator[int, string](utils.NumbersComparator[int])
+	new.Put(2, "B")
+	new.Put(3, "c")
+
+	patch := Diff(old, new, stringEqual)
+	assert.NoError(t, old.ApplyStrict(patch, stringEqual))
+	assert.Equal(t, []int{2, 3}, old.Keys())
+	assert.Equal(t, []string{"B", "c"}, old.Values())
+}
+
+func TestMapApplyStrictConflicts(t *testing.T) {
+	old := NewWithComparator[int, string](utils.NumbersComparator[int])
+	old.Put(1, "a")
+	old.Put(2, "b")
+	new := NewWithComparator[int, string](utils.NumbersComparator[int])
+	new.Put(2, "B")
+	new.Put(3, "c")
+	patch := Diff(old, new, stringEqual)
+
+	tests := []struct {
+		drift  func(m *Map[int, string])
+		key    int
+		reason string
+	}{
+		{func(m *Map[int, string]) { m.Remove(1) }, 1, "removed key is missing"},
+		{func(m *Map[int, string]) { m.Put(1, "x") }, 1, "removed value differs"},
+		{func(m *Map[int, string]) { m.Put(3, "x") }, 3, "added key is present"},
+		{func(m *Map[int, string]) { m.Remove(2) }, 2, "changed key is missing"},
+		{func(m *Map[int, string]) { m.Put(2, "x") }, 2, "changed value differs"},
+	}
+
+	for _, test := range tests {
+		drifted := NewWithComparator[int, string](utils.NumbersComparator[int])
+		drifted.Put(1, "a")
+		drifted.Put(2, "b")
+		test.drift(drifted)
+		keys, values := drifted.Keys(), drifted.Values()
+
+		err := drifted.ApplyStrict(patch, stringEqual)
+		var conflict *ConflictError[int]
+		assert.True(t, errors.As(err, &conflict))
+		assert.Equal(t, test.key, conflict.Key)
+		assert.Equal(t, test.reason, conflict.Reason)
+		assert.Equal(t, keys, drifted.Keys())
+		assert.Equal(t, values, drifted.Values())
+	}
+}
+
+func TestMapPatchJSON(t *testing.T) {
+	old := NewWithComparator[int, string](utils.NumbersComparator[int])
+	old.Put(1, "a")
+	old.Put(2, "b")
+	new := NewWithComparator[int, string](utils.NumbersComparator[int])
+	new.Put(2, "B")
+	new.Put(3, "c")
+	patch := Diff(old, new, stringEqual)
+
+	data, err := json.Marshal(patch)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"added": [{"key": 3, "value": "c"}],
+		"removed": [{"key": 1, "value": "a"}],
+		"changed": [{"key": 2, "old": "b", "new": "B"}]
+	}`, string(data))
+
+	var decoded Patch[int, string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, patch, decoded)
+
+	assert.NoError(t, old.ApplyStrict(decoded, stringEqual))
+	assert.True(t, old.Equal(new, stringEqual))
+}
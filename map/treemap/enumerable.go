@@ -1,6 +1,11 @@
 package treemap
 
-import "github.com/mikekonan/gods-generic/tree/redblacktree"
+import (
+	"fmt"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
 
 // Each calls the given function once for each element, passing that element's key and value.
 func (m *Map[K, V]) Each(f func(key K, value V)) {
@@ -22,6 +27,49 @@ func (m *Map[K, V]) Map(f func(key1 K, value1 V) (K, V)) *Map[K, V] {
 	return newMap
 }
 
+// MapMerge works like Map, but when the given function produces a key more than once,
+// the value stored for it is merge(existing, incoming) instead of the incoming value.
+func (m *Map[K, V]) MapMerge(f func(key1 K, value1 V) (K, V), merge func(existing, incoming V) V) *Map[K, V] {
+	newMap := &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](m.tree.Comparator)}
+	iterator := m.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		newMap.tree.Merge(key2, value2, merge)
+	}
+	return newMap
+}
+
+// MapWithPolicy works like Map, but resolves keys produced more than once by the given function according to the policy.
+// Under utils.FailOnCollision it returns a *utils.CollisionError listing all colliding keys instead of the container.
+// Panics if the policy is unknown.
+func (m *Map[K, V]) MapWithPolicy(f func(key1 K, value1 V) (K, V), policy utils.CollisionPolicy) (*Map[K, V], error) {
+	switch policy {
+	case utils.KeepLast, utils.KeepFirst, utils.FailOnCollision:
+	default:
+		panic(fmt.Sprintf("treemap: unknown collision policy %d", policy))
+	}
+	newMap := &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](m.tree.Comparator)}
+	collisions := redblacktree.NewWithComparator[K, struct{}](m.tree.Comparator)
+	iterator := m.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		switch policy {
+		case utils.KeepFirst:
+			newMap.tree.PutIfAbsent(key2, value2)
+		case utils.FailOnCollision:
+			if _, loaded := newMap.tree.PutIfAbsent(key2, value2); loaded {
+				collisions.Put(key2, struct{}{})
+			}
+		case utils.KeepLast:
+			newMap.tree.Put(key2, value2)
+		}
+	}
+	if !collisions.Empty() {
+		return nil, &utils.CollisionError[K]{Keys: collisions.Keys()}
+	}
+	return newMap, nil
+}
+
 // Select returns a new container containing all elements for which the given function returns a true value.
 func (m *Map[K, V]) Select(f func(key K, value V) bool) *Map[K, V] {
 	newMap := &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](m.tree.Comparator)}
@@ -60,8 +108,8 @@ func (m *Map[K, V]) All(f func(key K, value V) bool) bool {
 }
 
 // Find passes each element of the container to the given function and returns
-// the first (key,value) for which the function is true or nil,nil otherwise if no element
-// matches the criteria.
+// the first (key,value) for which the function is true or zero values otherwise if no element
+// matches the criteria. Use FindEntry to tell that apart from a matching zero key.
 func (m *Map[K, V]) Find(f func(key K, value V) bool) (k K, v V) {
 	iterator := m.Iterator()
 	for iterator.Next() {
@@ -72,3 +120,17 @@ func (m *Map[K, V]) Find(f func(key K, value V) bool) (k K, v V) {
 
 	return
 }
+
+// FindEntry passes each element of the container to the given function and returns
+// the first entry for which the function is true.
+// Second return parameter is true if an element matches the criteria, otherwise false.
+func (m *Map[K, V]) FindEntry(f func(key K, value V) bool) (entry Entry[K, V], found bool) {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return Entry[K, V]{Key: iterator.Key(), Value: iterator.Value()}, true
+		}
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package treemap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapMapMerge(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(11, "c")
+	m.Put(12, "d")
+
+	merged := m.MapMerge(func(key int, value string) (int, string) {
+		return key % 10, value
+	}, func(existing, incoming string) string {
+		return existing + incoming
+	})
+	assert.Equal(t, []int{1, 2}, merged.Keys())
+	assert.Equal(t, []string{"ac", "bd"}, merged.Values())
+}
+
+func TestMapMapWithPolicy(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(11, "c")
+	m.Put(21, "d")
+	modulo := func(key int, value string) (int, string) {
+		return key % 10, value
+	}
+
+	tests := []struct {
+		policy utils.CollisionPolicy
+		values []string
+	}{
+		{utils.KeepLast, []string{"d", "b"}},
+		{utils.KeepFirst, []string{"a", "b"}},
+	}
+	for _, test := range tests {
+		mapped, err := m.MapWithPolicy(modulo, test.policy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, mapped.Keys())
+		assert.Equal(t, test.values, mapped.Values())
+	}
+
+	mapped, err := m.MapWithPolicy(modulo, utils.FailOnCollision)
+	assert.Nil(t, mapped)
+	var collision *utils.CollisionError[int]
+	assert.True(t, errors.As(err, &collision))
+	assert.Equal(t, []int{1}, collision.Keys)
+
+	mapped, err = m.MapWithPolicy(func(key int, value string) (int, string) {
+		return -key, value
+	}, utils.FailOnCollision)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{-21, -11, -2, -1}, mapped.Keys())
+}
+
+func TestMapMapWithUnknownPolicy(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	identity := func(key int, value string) (int, string) {
+		return key, value
+	}
+
+	assert.PanicsWithValue(t, "treemap: unknown collision policy 7", func() {
+		m.MapWithPolicy(identity, utils.CollisionPolicy(7))
+	})
+}
@@ -0,0 +1,143 @@
+package treemap
+
+import (
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// MapTo invokes the given function once for each element and returns a new map ordered by the given comparator
+// containing the key/value pairs returned by the function, which may be of different types than the map's.
+func MapTo[K any, V any, K2 any, V2 any](m *Map[K, V], comparator utils.Comparator[K2], f func(key K, value V) (K2, V2)) *Map[K2, V2] {
+	newMap := NewWithComparator[K2, V2](comparator)
+	iterator := m.Iterator()
+	for iterator.Next() {
+		newMap.Put(f(iterator.Key(), iterator.Value()))
+	}
+	return newMap
+}
+
+// MapValues invokes the given function once for each element and returns a new map with the same keys
+// and the values returned by the function. Runs in O(n).
+func MapValues[K any, V any, V2 any](m *Map[K, V], f func(key K, value V) V2) *Map[K, V2] {
+	keys := make([]K, 0, m.Size())
+	values := make([]V2, 0, m.Size())
+	iterator := m.Iterator()
+	for iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, f(iterator.Key(), iterator.Value()))
+	}
+	newMap := NewWithComparator[K, V2](m.tree.Comparator)
+	newMap.tree.Load(keys, values)
+	return newMap
+}
+
+// FlatMap invokes the given function once for each element and returns a new map ordered by the given comparator
+// containing all entries returned by the function.
+func FlatMap[K any, V any, K2 any, V2 any](m *Map[K, V], comparator utils.Comparator[K2], f func(key K, value V) []Entry[K2, V2]) *Map[K2, V2] {
+	newMap := NewWithComparator[K2, V2](comparator)
+	iterator := m.Iterator()
+	for iterator.Next() {
+		for _, entry := range f(iterator.Key(), iterator.Value()) {
+			newMap.Put(entry.Key, entry.Value)
+		}
+	}
+	return newMap
+}
+
+// Fold passes each element in-order to the given function together with the accumulated value,
+// starting from initial, and returns the final accumulated value.
+func Fold[K any, V any, A any](m *Map[K, V], initial A, f func(acc A, key K, value V) A) A {
+	acc := initial
+	iterator := m.Iterator()
+	for iterator.Next() {
+		acc = f(acc, iterator.Key(), iterator.Value())
+	}
+	return acc
+}
+
+// Reduce passes each element but the first in-order to the given function together with the accumulated value,
+// starting from the first value, and returns the final accumulated value.
+// Second return parameter is true if map is not empty, otherwise false.
+func Reduce[K any, V any](m *Map[K, V], f func(acc V, key K, value V) V) (result V, found bool) {
+	iterator := m.Iterator()
+	if !iterator.Next() {
+		return
+	}
+	result = iterator.Value()
+	for iterator.Next() {
+		result = f(result, iterator.Key(), iterator.Value())
+	}
+	return result, true
+}
+
+// GroupBy splits the map into groups keyed by the result of the given function,
+// ordered by the given comparator. Every group is a map with the same comparator as the original map.
+func GroupBy[K any, V any, G any](m *Map[K, V], comparator utils.Comparator[G], f func(key K, value V) G) *Map[G, *Map[K, V]] {
+	groups := NewWithComparator[G, *Map[K, V]](comparator)
+	iterator := m.Iterator()
+	for iterator.Next() {
+		group, _ := groups.GetOrPut(f(iterator.Key(), iterator.Value()), func() *Map[K, V] {
+			return NewWithComparator[K, V](m.tree.Comparator)
+		})
+		group.Put(iterator.Key(), iterator.Value())
+	}
+	return groups
+}
+
+// Partition splits the map into the elements for which the given function returns true and the rest.
+// Runs in O(n).
+func Partition[K any, V any](m *Map[K, V], f func(key K, value V) bool) (matched *Map[K, V], rest *Map[K, V]) {
+	var matchedKeys, restKeys []K
+	var matchedValues, restValues []V
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			matchedKeys = append(matchedKeys, iterator.Key())
+			matchedValues = append(matchedValues, iterator.Value())
+		} else {
+			restKeys = append(restKeys, iterator.Key())
+			restValues = append(restValues, iterator.Value())
+		}
+	}
+	matched = &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](m.tree.Comparator)}
+	matched.tree.Load(matchedKeys, matchedValues)
+	rest = &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](m.tree.Comparator)}
+	rest.tree.Load(restKeys, restValues)
+	return matched, rest
+}
+
+// Count returns the number of elements for which the given function returns true.
+func Count[K any, V any](m *Map[K, V], f func(key K, value V) bool) int {
+	count := 0
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// MinBy returns the first entry for which the given function returns the smallest result according to the comparator.
+// Second return parameter is true if map is not empty, otherwise false.
+func MinBy[K any, V any, T any](m *Map[K, V], comparator utils.Comparator[T], f func(key K, value V) T) (entry Entry[K, V], found bool) {
+	return extremeBy(m, comparator, f, -1)
+}
+
+// MaxBy returns the first entry for which the given function returns the largest result according to the comparator.
+// Second return parameter is true if map is not empty, otherwise false.
+func MaxBy[K any, V any, T any](m *Map[K, V], comparator utils.Comparator[T], f func(key K, value V) T) (entry Entry[K, V], found bool) {
+	return extremeBy(m, comparator, f, 1)
+}
+
+func extremeBy[K any, V any, T any](m *Map[K, V], comparator utils.Comparator[T], f func(key K, value V) T, sign int) (entry Entry[K, V], found bool) {
+	var best T
+	iterator := m.Iterator()
+	for iterator.Next() {
+		result := f(iterator.Key(), iterator.Value())
+		if !found || comparator(result, best)*sign > 0 {
+			entry, best, found = Entry[K, V]{Key: iterator.Key(), Value: iterator.Value()}, result, true
+		}
+	}
+	return entry, found
+}
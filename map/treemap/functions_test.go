@@ -0,0 +1,192 @@
+package treemap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFunctionsMapTo(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "c")
+	m.Put(2, "a")
+	m.Put(3, "b")
+
+	inverted := MapTo(m, utils.StringComparator, func(key int, value string) (string, int) {
+		return value, key
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, inverted.Keys())
+	assert.Equal(t, []int{2, 3, 1}, inverted.Values())
+}
+
+func TestMapFunctionsMapValues(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "bb")
+
+	lengths := MapValues(m, func(key int, value string) int {
+		return key * len(value)
+	})
+	assert.Equal(t, []int{1, 2}, lengths.Keys())
+	assert.Equal(t, []int{1, 4}, lengths.Values())
+}
+
+func TestMapFunctionsFlatMap(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "ab")
+	m.Put(2, "")
+	m.Put(3, "ca")
+
+	letters := FlatMap(m, utils.StringComparator, func(key int, value string) []Entry[string, int] {
+		var entries []Entry[string, int]
+		for _, letter := range strings.Split(value, "") {
+			entries = append(entries, Entry[string, int]{Key: letter, Value: key})
+		}
+		return entries
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, letters.Keys())
+	assert.Equal(t, []int{3, 1, 3}, letters.Values())
+}
+
+func TestMapFunctionsFold(t *testing.T) {
+	tests := []struct {
+		keys     []int
+		expected string
+	}{
+		{nil, ">"},
+		{[]int{1}, ">1"},
+		{[]int{3, 1, 2}, ">123"},
+	}
+
+	for _, test := range tests {
+		m := NewWithComparator[int, string](utils.NumbersComparator[int])
+		for _, key := range test.keys {
+			m.Put(key, string(rune('0'+key)))
+		}
+		actual := Fold(m, ">", func(acc string, key int, value string) string {
+			return acc + value
+		})
+		assert.Equal(t, test.expected, actual)
+	}
+}
+
+func TestMapFunctionsReduce(t *testing.T) {
+	tests := []struct {
+		keys     []int
+		expected int
+		found    bool
+	}{
+		{nil, 0, false},
+		{[]int{5}, 50, true},
+		{[]int{1, 2, 3}, 60, true},
+	}
+
+	for _, test := range tests {
+		m := NewWithComparator[int, int](utils.NumbersComparator[int])
+		for _, key := range test.keys {
+			m.Put(key, key*10)
+		}
+		actual, found := Reduce(m, func(acc int, key int, value int) int {
+			return acc + value
+		})
+		assert.Equal(t, test.expected, actual)
+		assert.Equal(t, test.found, found)
+	}
+}
+
+func TestMapFunctionsGroupBy(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	m.Put(4, "d")
+
+	groups := GroupBy(m, utils.StringComparator, func(key int, value string) string {
+		if key%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	assert.Equal(t, []string{"even", "odd"}, groups.Keys())
+	even, _ := groups.Get("even")
+	assert.Equal(t, []int{2, 4}, even.Keys())
+	odd, _ := groups.Get("odd")
+	assert.Equal(t, []string{"a", "c"}, odd.Values())
+}
+
+func TestMapFunctionsPartition(t *testing.T) {
+	tests := []struct {
+		keys    []int
+		matched []int
+		rest    []int
+	}{
+		{nil, []int{}, []int{}},
+		{[]int{1, 3}, []int{}, []int{1, 3}},
+		{[]int{4, 1, 2, 3}, []int{2, 4}, []int{1, 3}},
+	}
+
+	for _, test := range tests {
+		m := NewWithComparator[int, int](utils.NumbersComparator[int])
+		for _, key := range test.keys {
+			m.Put(key, key)
+		}
+		matched, rest := Partition(m, func(key int, value int) bool {
+			return key%2 == 0
+		})
+		assert.Equal(t, test.matched, matched.Keys())
+		assert.Equal(t, test.rest, rest.Keys())
+		assert.Equal(t, len(test.keys), m.Size())
+	}
+}
+
+func TestMapFunctionsCount(t *testing.T) {
+	tests := []struct {
+		keys     []int
+		expected int
+	}{
+		{nil, 0},
+		{[]int{1, 3}, 0},
+		{[]int{1, 2, 3, 4}, 2},
+	}
+
+	for _, test := range tests {
+		m := NewWithComparator[int, int](utils.NumbersComparator[int])
+		for _, key := range test.keys {
+			m.Put(key, key)
+		}
+		assert.Equal(t, test.expected, Count(m, func(key int, value int) bool {
+			return key%2 == 0
+		}))
+	}
+}
+
+func TestMapFunctionsMinByMaxBy(t *testing.T) {
+	tests := []struct {
+		values []string
+		min    Entry[int, string]
+		max    Entry[int, string]
+		found  bool
+	}{
+		{nil, Entry[int, string]{}, Entry[int, string]{}, false},
+		{[]string{"a"}, Entry[int, string]{0, "a"}, Entry[int, string]{0, "a"}, true},
+		{[]string{"bb", "a", "ccc", "d", "eee"}, Entry[int, string]{1, "a"}, Entry[int, string]{2, "ccc"}, true},
+	}
+
+	length := func(key int, value string) int {
+		return len(value)
+	}
+	for _, test := range tests {
+		m := NewWithComparator[int, string](utils.NumbersComparator[int])
+		for key, value := range test.values {
+			m.Put(key, value)
+		}
+		min, found := MinBy(m, utils.NumbersComparator[int], length)
+		assert.Equal(t, test.min, min)
+		assert.Equal(t, test.found, found)
+		max, found := MaxBy(m, utils.NumbersComparator[int], length)
+		assert.Equal(t, test.max, max)
+		assert.Equal(t, test.found, found)
+	}
+}
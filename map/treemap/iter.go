@@ -0,0 +1,43 @@
+//go:build go1.23
+
+package treemap
+
+import (
+	"iter"
+
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Collect instantiates a tree map with the custom comparator holding the key/value pairs of the sequence.
+func Collect[K any, V any](comparator utils.Comparator[K], seq iter.Seq2[K, V]) *Map[K, V] {
+	m := NewWithComparator[K, V](comparator)
+	for key, value := range seq {
+		m.Put(key, value)
+	}
+	return m
+}
+
+// AllSeq returns an iterator over all key/value pairs in-order.
+func (m *Map[K, V]) AllSeq() iter.Seq2[K, V] {
+	return m.tree.AllSeq()
+}
+
+// KeysSeq returns an iterator over all keys in-order.
+func (m *Map[K, V]) KeysSeq() iter.Seq[K] {
+	return m.tree.KeysSeq()
+}
+
+// ValuesSeq returns an iterator over all values in-order based on the key.
+func (m *Map[K, V]) ValuesSeq() iter.Seq[V] {
+	return m.tree.ValuesSeq()
+}
+
+// BackwardSeq returns an iterator over all key/value pairs in-reverse-order.
+func (m *Map[K, V]) BackwardSeq() iter.Seq2[K, V] {
+	return m.tree.BackwardSeq()
+}
+
+// RangeSeq returns an iterator over the key/value pairs whose keys range from lo, inclusive, to hi, exclusive, in-order.
+func (m *Map[K, V]) RangeSeq(lo, hi K) iter.Seq2[K, V] {
+	return m.tree.RangeSeq(lo, hi)
+}
@@ -0,0 +1,164 @@
+//go:build go1.23
+
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapAllSeq(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	var keys []int
+	var values []string
+	for key, value := range m.AllSeq() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	assert.Equal(t, []int{1, 2, 3}, keys)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
+
+func TestMapAllSeqBreak(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	var keys []int
+	for key := range m.AllSeq() {
+		keys = append(keys, key)
+		if key == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, keys)
+}
+
+func TestMapKeysSeq(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	var keys []int
+	for key := range m.KeysSeq() {
+		keys = append(keys, key)
+		if key == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, keys)
+}
+
+func TestMapValuesSeq(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	var values []string
+	for value := range m.ValuesSeq() {
+		values = append(values, value)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+
+	values = values[:0]
+	for value := range m.ValuesSeq() {
+		values = append(values, value)
+		break
+	}
+	assert.Equal(t, []string{"a"}, values)
+}
+
+func TestMapBackwardSeq(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	var keys []int
+	for key := range m.BackwardSeq() {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []int{3, 2, 1}, keys)
+
+	keys = keys[:0]
+	for key := range m.BackwardSeq() {
+		keys = append(keys, key)
+		if key == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{3, 2}, keys)
+}
+
+func TestMapRangeSeq(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for i := 1; i <= 5; i++ {
+		m.Put(i, string(rune('a'+i-1)))
+	}
+
+	var keys []int
+	for key := range m.RangeSeq(2, 5) {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []int{2, 3, 4}, keys)
+	assert.Equal(t, m.SubMap(2, 5).Keys(), keys)
+
+	keys = keys[:0]
+	for key := range m.RangeSeq(2, 5) {
+		keys = append(keys, key)
+		if key == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 3}, keys)
+
+	for range m.RangeSeq(4, 2) {
+		t.Fatal("an inverted range yielded a key")
+	}
+}
+
+func TestMapSeqSeesViewWrites(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(5, "e")
+	view := m.SubMap(2, 5)
+
+	assert.NoError(t, view.Put(3, "c"))
+	var keys []int
+	for key := range m.KeysSeq() {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []int{1, 3, 5}, keys)
+
+	view.Remove(3)
+	for key := range m.RangeSeq(2, 5) {
+		t.Fatalf("key %d removed through the view was yielded", key)
+	}
+}
+
+func TestMapCollect(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	collected := Collect(utils.NumbersComparator[int], m.BackwardSeq())
+	assert.Equal(t, []int{1, 2, 3}, collected.Keys())
+	assert.Equal(t, []string{"a", "b", "c"}, collected.Values())
+
+	// later pairs win for duplicate keys
+	collected = Collect(utils.NumbersComparator[int], func(yield func(int, string) bool) {
+		_ = yield(1, "a") && yield(1, "b")
+	})
+	assert.Equal(t, []string{"b"}, collected.Values())
+	assert.True(t, Collect(utils.NumbersComparator[int], NewWithComparator[int, string](utils.NumbersComparator[int]).AllSeq()).Empty())
+}
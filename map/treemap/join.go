@@ -0,0 +1,122 @@
+package treemap
+
+import (
+	"fmt"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// JoinKind selects the keys emitted by a join of two maps.
+type JoinKind int
+
+const (
+	// Inner emits the keys present in both maps.
+	Inner JoinKind = iota
+	// LeftOuter emits the keys present in the left map.
+	LeftOuter
+	// FullOuter emits the keys present in either map.
+	FullOuter
+	// Anti emits the keys present in the left map only.
+	Anti
+)
+
+// Pair holds the values of a key in both joined maps and whether the key is present in each of them.
+type Pair[A any, B any] struct {
+	Left     A
+	Right    B
+	HasLeft  bool
+	HasRight bool
+}
+
+// InnerJoin calls f in-order for every key present in both maps.
+// Runs in O(m+n). Panics if the maps use different comparators.
+func InnerJoin[K any, A any, B any](left *Map[K, A], right *Map[K, B], f func(key K, pair Pair[A, B])) {
+	Join(left, right, Inner, f)
+}
+
+// LeftJoin calls f in-order for every key present in the left map.
+// Runs in O(m+n). Panics if the maps use different comparators.
+func LeftJoin[K any, A any, B any](left *Map[K, A], right *Map[K, B], f func(key K, pair Pair[A, B])) {
+	Join(left, right, LeftOuter, f)
+}
+
+// FullOuterJoin calls f in-order for every key present in either map.
+// Runs in O(m+n). Panics if the maps use different comparators.
+func FullOuterJoin[K any, A any, B any](left *Map[K, A], right *Map[K, B], f func(key K, pair Pair[A, B])) {
+	Join(left, right, FullOuter, f)
+}
+
+// AntiJoin calls f in-order for every key present in the left map only.
+// Runs in O(m+n). Panics if the maps use different comparators.
+func AntiJoin[K any, A any, B any](left *Map[K, A], right *Map[K, B], f func(key K, pair Pair[A, B])) {
+	Join(left, right, Anti, f)
+}
+
+// JoinMap joins both maps into a new map holding a pair for every key emitted by the join of the given kind.
+// Runs in O(m+n). Panics if the maps use different comparators or the kind is unknown.
+func JoinMap[K any, A any, B any](left *Map[K, A], right *Map[K, B], kind JoinKind) *Map[K, Pair[A, B]] {
+	var keys []K
+	var pairs []Pair[A, B]
+	Join(left, right, kind, func(key K, pair Pair[A, B]) {
+		keys = append(keys, key)
+		pairs = append(pairs, pair)
+	})
+	joined := &Map[K, Pair[A, B]]{tree: redblacktree.NewWithComparator[K, Pair[A, B]](left.tree.Comparator)}
+	joined.tree.Load(keys, pairs)
+	return joined
+}
+
+// Join walks both maps in lock-step and calls f in-order for every key emitted by the join of the given kind.
+// Runs in O(m+n). Panics if the maps use different comparators or the kind is unknown.
+func Join[K any, A any, B any](left *Map[K, A], right *Map[K, B], kind JoinKind, f func(key K, pair Pair[A, B])) {
+	if !utils.SameComparator(left.tree.Comparator, right.tree.Comparator) {
+		panic("treemap: maps use different comparators")
+	}
+	comparator := left.tree.Comparator
+	a, b := left.tree.Iterator(), right.tree.Iterator()
+	okA, okB := a.Next(), b.Next()
+	for kind.proceeds(okA, okB) {
+		var compare int
+		switch {
+		case !okB:
+			compare = -1
+		case !okA:
+			compare = 1
+		default:
+			compare = comparator(a.Key(), b.Key())
+		}
+
+		switch {
+		case compare < 0:
+			if kind != Inner {
+				f(a.Key(), Pair[A, B]{Left: a.Value(), HasLeft: true})
+			}
+			okA = a.Next()
+		case compare > 0:
+			if kind == FullOuter {
+				f(b.Key(), Pair[A, B]{Right: b.Value(), HasRight: true})
+			}
+			okB = b.Next()
+		default:
+			if kind != Anti {
+				f(a.Key(), Pair[A, B]{Left: a.Value(), Right: b.Value(), HasLeft: true, HasRight: true})
+			}
+			okA, okB = a.Next(), b.Next()
+		}
+	}
+}
+
+// proceeds reports whether a join of the kind can still emit keys, given which maps have elements left.
+func (kind JoinKind) proceeds(okA, okB bool) bool {
+	switch kind {
+	case Inner:
+		return okA && okB
+	case LeftOuter, Anti:
+		return okA
+	case FullOuter:
+		return okA || okB
+	default:
+		panic(fmt.Sprintf("treemap: unknown join kind %d", kind))
+	}
+}
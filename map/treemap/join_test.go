@@ -0,0 +1,168 @@
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type joined struct {
+	key  int
+	pair Pair[string, int]
+}
+
+func collectJoin(left *Map[int, string], right *Map[int, int], kind JoinKind) []joined {
+	var rows []joined
+	Join(left, right, kind, func(key int, pair Pair[string, int]) {
+		rows = append(rows, joined{key, pair})
+	})
+	return rows
+}
+
+func TestMapJoinInner(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	left.Put(2, "b")
+	left.Put(4, "d")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+	right.Put(2, 20)
+	right.Put(3, 30)
+	right.Put(4, 40)
+
+	assert.Equal(t, []joined{
+		{2, Pair[string, int]{Left: "b", Right: 20, HasLeft: true, HasRight: true}},
+		{4, Pair[string, int]{Left: "d", Right: 40, HasLeft: true, HasRight: true}},
+	}, collectJoin(left, right, Inner))
+}
+
+func TestMapJoinLeftOuter(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	left.Put(2, "b")
+	left.Put(5, "e")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+	right.Put(2, 20)
+	right.Put(3, 30)
+
+	assert.Equal(t, []joined{
+		{1, Pair[string, int]{Left: "a", HasLeft: true}},
+		{2, Pair[string, int]{Left: "b", Right: 20, HasLeft: true, HasRight: true}},
+		{5, Pair[string, int]{Left: "e", HasLeft: true}},
+	}, collectJoin(left, right, LeftOuter))
+}
+
+func TestMapJoinFullOuter(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	left.Put(2, "b")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+	right.Put(2, 20)
+	right.Put(3, 30)
+	right.Put(4, 40)
+
+	assert.Equal(t, []joined{
+		{1, Pair[string, int]{Left: "a", HasLeft: true}},
+		{2, Pair[string, int]{Left: "b", Right: 20, HasLeft: true, HasRight: true}},
+		{3, Pair[string, int]{Right: 30, HasRight: true}},
+		{4, Pair[string, int]{Right: 40, HasRight: true}},
+	}, collectJoin(left, right, FullOuter))
+}
+
+func TestMapJoinAnti(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	left.Put(2, "b")
+	left.Put(5, "e")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+	right.Put(2, 20)
+	right.Put(3, 30)
+
+	assert.Equal(t, []joined{
+		{1, Pair[string, int]{Left: "a", HasLeft: true}},
+		{5, Pair[string, int]{Left: "e", HasLeft: true}},
+	}, collectJoin(left, right, Anti))
+}
+
+func TestMapJoinEmptyLeft(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+	right.Put(1, 10)
+
+	assert.Empty(t, collectJoin(left, right, Inner))
+	assert.Empty(t, collectJoin(left, right, LeftOuter))
+	assert.Empty(t, collectJoin(left, right, Anti))
+	assert.Equal(t, []joined{
+		{1, Pair[string, int]{Right: 10, HasRight: true}},
+	}, collectJoin(left, right, FullOuter))
+}
+
+func TestMapJoinEmptyRight(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+
+	only := []joined{{1, Pair[string, int]{Left: "a", HasLeft: true}}}
+	assert.Empty(t, collectJoin(left, right, Inner))
+	assert.Equal(t, only, collectJoin(left, right, LeftOuter))
+	assert.Equal(t, only, collectJoin(left, right, Anti))
+	assert.Equal(t, only, collectJoin(left, right, FullOuter))
+}
+
+var comparisons int
+
+func countingComparator(a, b int) int {
+	comparisons++
+	return utils.NumbersComparator(a, b)
+}
+
+func TestMapJoinInnerStopsEarly(t *testing.T) {
+	left := NewWithComparator[int, string](countingComparator)
+	for i := 0; i < 100; i++ {
+		left.Put(i, "x")
+	}
+	right := NewWithComparator[int, int](countingComparator)
+	right.Put(0, 0)
+
+	// walking the rest of the left map would take at least one comparison per key
+	comparisons = 0
+	assert.Len(t, collectJoin(left, right, Inner), 1)
+	assert.Less(t, comparisons, 10)
+}
+
+func TestMapJoinMap(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	left.Put(2, "b")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+	right.Put(2, 20)
+
+	m := JoinMap(left, right, LeftOuter)
+	assert.Equal(t, []int{1, 2}, m.Keys())
+	pair, found := m.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, Pair[string, int]{Left: "b", Right: 20, HasLeft: true, HasRight: true}, pair)
+}
+
+func TestMapJoinDifferentComparators(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	right := NewWithComparator[int, int](func(a, b int) int { return b - a })
+
+	assert.PanicsWithValue(t, "treemap: maps use different comparators", func() {
+		Join(left, right, Inner, func(key int, pair Pair[string, int]) {})
+	})
+}
+
+func TestMapJoinUnknownKind(t *testing.T) {
+	left := NewWithComparator[int, string](utils.NumbersComparator[int])
+	left.Put(1, "a")
+	right := NewWithComparator[int, int](utils.NumbersComparator[int])
+
+	assert.PanicsWithValue(t, "treemap: unknown join kind 42", func() {
+		Join(left, right, JoinKind(42), func(key int, pair Pair[string, int]) {})
+	})
+	// empty maps are no excuse for an unknown kind
+	assert.PanicsWithValue(t, "treemap: unknown join kind -1", func() {
+		JoinMap(right, right, JoinKind(-1))
+	})
+}
@@ -8,10 +8,17 @@ import (
 	"github.com/mikekonan/gods-generic/utils"
 )
 
+// Map holds the elements in a red-black tree
 type Map[K any, V any] struct {
 	tree *redblacktree.Tree[K, V]
 }
 
+// Entry is a key-value pair of the map.
+type Entry[K any, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
 // NewWithComparator instantiates a tree map with the custom comparator.
 func NewWithComparator[K any, V any](comparator utils.Comparator[K]) *Map[K, V] {
 	return &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](comparator)}
@@ -23,6 +30,50 @@ func (m *Map[K, V]) Put(key K, value V) {
 	m.tree.Put(key, value)
 }
 
+// PutIfAbsent inserts key-value pair into the map only if the key is not present yet.
+// Returns the value already associated with the key and true if it was present, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) PutIfAbsent(key K, value V) (previous V, loaded bool) {
+	return m.tree.PutIfAbsent(key, value)
+}
+
+// Replace updates the value of the key only if the key is already present.
+// Returns the previous value and true if it was replaced, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Replace(key K, value V) (previous V, replaced bool) {
+	return m.tree.Replace(key, value)
+}
+
+// Swap inserts key-value pair into the map and returns the value it replaced.
+// Second return parameter is true if key was present before, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	return m.tree.Swap(key, value)
+}
+
+// GetOrPut returns the value of the key, inserting the value returned by f if the key is not present.
+// Second return parameter is true if key was present before, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) GetOrPut(key K, f func() V) (value V, loaded bool) {
+	return m.tree.GetOrPut(key, f)
+}
+
+// Compute computes a new value of the key from its current value with a single walk of the tree.
+// f receives the current value and whether the key is present, and returns the new value and whether to keep it.
+// If keep is false, the key is removed from the map (or not inserted if it was absent).
+// Returns the resulting value and true if the key is present afterwards, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Compute(key K, f func(old V, exists bool) (newValue V, keep bool)) (value V, present bool) {
+	return m.tree.Compute(key, f)
+}
+
+// Merge inserts the value if the key is not present, otherwise replaces the current value by f(current, value).
+// Returns the resulting value.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) Merge(key K, value V, f func(old, new V) V) V {
+	return m.tree.Merge(key, value, f)
+}
+
 // Get searches the element in the map by key and returns its value or nil if key is not found in tree.
 // Second return parameter is true if key was found, otherwise false.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
@@ -36,6 +87,50 @@ func (m *Map[K, V]) Remove(key K) {
 	m.tree.Remove(key)
 }
 
+// PollFirst removes the entry with the minimum key from the map and returns it.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) PollFirst() (entry Entry[K, V], found bool) {
+	if node, found := m.tree.RemoveMin(); found {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
+// PollLast removes the entry with the maximum key from the map and returns it.
+// Second return parameter is true if map was not empty, otherwise false.
+func (m *Map[K, V]) PollLast() (entry Entry[K, V], found bool) {
+	if node, found := m.tree.RemoveMax(); found {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
+// PollFirstN removes up to n entries with the smallest keys from the map and returns them in-order.
+func (m *Map[K, V]) PollFirstN(n int) []Entry[K, V] {
+	var entries []Entry[K, V]
+	for ; n > 0; n-- {
+		node, found := m.tree.RemoveMin()
+		if !found {
+			break
+		}
+		entries = append(entries, Entry[K, V]{Key: node.Key, Value: node.Value})
+	}
+	return entries
+}
+
+// PollLastN removes up to n entries with the largest keys from the map and returns them in-reverse-order.
+func (m *Map[K, V]) PollLastN(n int) []Entry[K, V] {
+	var entries []Entry[K, V]
+	for ; n > 0; n-- {
+		node, found := m.tree.RemoveMax()
+		if !found {
+			break
+		}
+		entries = append(entries, Entry[K, V]{Key: node.Key, Value: node.Value})
+	}
+	return entries
+}
+
 // Empty returns true if map does not contain any elements
 func (m *Map[K, V]) Empty() bool {
 	return m.tree.Empty()
@@ -67,7 +162,7 @@ func (m *Map[K, V]) Clear() {
 }
 
 // Min returns the minimum key and its value from the tree map.
-// Returns nil, nil if map is empty.
+// Returns zero values if map is empty, use MinEntry to tell that apart from a zero key.
 func (m *Map[K, V]) Min() (key K, value V) {
 	if node := m.tree.Left(); node != nil {
 		return node.Key, node.Value
@@ -75,8 +170,17 @@ func (m *Map[K, V]) Min() (key K, value V) {
 	return
 }
 
+// MinEntry returns the entry with the minimum key from the tree map.
+// Second return parameter is true if map is not empty, otherwise false.
+func (m *Map[K, V]) MinEntry() (entry Entry[K, V], found bool) {
+	if node := m.tree.Left(); node != nil {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
 // Max returns the maximum key and its value from the tree map.
-// Returns nil, nil if map is empty.
+// Returns zero values if map is empty, use MaxEntry to tell that apart from a zero key.
 func (m *Map[K, V]) Max() (key K, value V) {
 	if node := m.tree.Right(); node != nil {
 		return node.Key, node.Value
@@ -84,9 +188,18 @@ func (m *Map[K, V]) Max() (key K, value V) {
 	return
 }
 
+// MaxEntry returns the entry with the maximum key from the tree map.
+// Second return parameter is true if map is not empty, otherwise false.
+func (m *Map[K, V]) MaxEntry() (entry Entry[K, V], found bool) {
+	if node := m.tree.Right(); node != nil {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
 // Floor finds the floor key-value pair for the input key.
-// In case that no floor is found, then both returned values will be nil.
-// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+// In case that no floor is found, then both returned values will be zero values.
+// Use FloorEntry to tell a missing floor apart from a zero key.
 //
 // Floor key is defined as the largest key that is smaller than or equal to the given key.
 // A floor key may not be found, either because the map is empty, or because
@@ -102,9 +215,20 @@ func (m *Map[K, V]) Floor(key K) (foundkey K, foundvalue V) {
 	return
 }
 
+// FloorEntry finds the floor entry for the input key.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) FloorEntry(key K) (entry Entry[K, V], found bool) {
+	if node, found := m.tree.Floor(key); found {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
 // Ceiling finds the ceiling key-value pair for the input key.
-// In case that no ceiling is found, then both returned values will be nil.
-// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+// In case that no ceiling is found, then both returned values will be zero values.
+// Use CeilingEntry to tell a missing ceiling apart from a zero key.
 //
 // Ceiling key is defined as the smallest key that is larger than or equal to the given key.
 // A ceiling key may not be found, either because the map is empty, or because
@@ -119,6 +243,17 @@ func (m *Map[K, V]) Ceiling(key K) (foundkey K, foundvalue V) {
 	return
 }
 
+// CeilingEntry finds the ceiling entry for the input key.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, V]) CeilingEntry(key K) (entry Entry[K, V], found bool) {
+	if node, found := m.tree.Ceiling(key); found {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (m *Map[K, V]) Iterator() Iterator[K, V] {
 	return Iterator[K, V]{iterator: m.tree.Iterator()}
@@ -0,0 +1,132 @@
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapMinMaxEntry(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(0, "zero")
+
+	entry, found := m.MinEntry()
+	assert.Equal(t, Entry[int, string]{0, "zero"}, entry)
+	assert.True(t, found)
+	entry, found = m.MaxEntry()
+	assert.Equal(t, Entry[int, string]{0, "zero"}, entry)
+	assert.True(t, found)
+
+	m.Put(-1, "minus")
+	m.Put(1, "one")
+	entry, _ = m.MinEntry()
+	assert.Equal(t, Entry[int, string]{-1, "minus"}, entry)
+	entry, _ = m.MaxEntry()
+	assert.Equal(t, Entry[int, string]{1, "one"}, entry)
+}
+
+func TestMapMinMaxEntryEmpty(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+
+	entry, found := m.MinEntry()
+	assert.Equal(t, Entry[int, string]{}, entry)
+	assert.False(t, found)
+	entry, found = m.MaxEntry()
+	assert.Equal(t, Entry[int, string]{}, entry)
+	assert.False(t, found)
+}
+
+func TestMapFloorCeilingEntry(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(0, "zero")
+	m.Put(5, "five")
+
+	tests := []struct {
+		key     int
+		floor   Entry[int, string]
+		floorOk bool
+		ceil    Entry[int, string]
+		ceilOk  bool
+	}{
+		{-1, Entry[int, string]{}, false, Entry[int, string]{0, "zero"}, true},
+		{0, Entry[int, string]{0, "zero"}, true, Entry[int, string]{0, "zero"}, true},
+		{3, Entry[int, string]{0, "zero"}, true, Entry[int, string]{5, "five"}, true},
+		{6, Entry[int, string]{5, "five"}, true, Entry[int, string]{}, false},
+	}
+
+	for _, test := range tests {
+		floor, found := m.FloorEntry(test.key)
+		assert.Equal(t, test.floor, floor)
+		assert.Equal(t, test.floorOk, found)
+		ceil, found := m.CeilingEntry(test.key)
+		assert.Equal(t, test.ceil, ceil)
+		assert.Equal(t, test.ceilOk, found)
+	}
+}
+
+func TestMapFloorCeilingEntryEmpty(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+
+	_, found := m.FloorEntry(0)
+	assert.False(t, found)
+	_, found = m.CeilingEntry(0)
+	assert.False(t, found)
+}
+
+func TestMapPollFirst(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "one")
+	m.Put(0, "zero")
+
+	entry, found := m.PollFirst()
+	assert.Equal(t, Entry[int, string]{0, "zero"}, entry)
+	assert.True(t, found)
+	assert.Equal(t, []int{1}, m.Keys())
+	entry, _ = m.PollFirst()
+	assert.Equal(t, Entry[int, string]{1, "one"}, entry)
+	_, found = m.PollFirst()
+	assert.False(t, found)
+}
+
+func TestMapPollLast(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(0, "zero")
+	m.Put(2, "two")
+
+	entry, found := m.PollLast()
+	assert.Equal(t, Entry[int, string]{2, "two"}, entry)
+	assert.True(t, found)
+	assert.Equal(t, []int{0}, m.Keys())
+	entry, _ = m.PollLast()
+	assert.Equal(t, Entry[int, string]{0, "zero"}, entry)
+	_, found = m.PollLast()
+	assert.False(t, found)
+}
+
+func TestMapPollFirstN(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(0, "a")
+	m.Put(1, "b")
+	m.Put(2, "c")
+
+	assert.Empty(t, m.PollFirstN(0))
+	assert.Empty(t, m.PollFirstN(-1))
+	assert.Equal(t, []Entry[int, string]{{0, "a"}, {1, "b"}}, m.PollFirstN(2))
+	assert.Equal(t, []Entry[int, string]{{2, "c"}}, m.PollFirstN(5))
+	assert.True(t, m.Empty())
+	assert.Empty(t, m.PollFirstN(1))
+}
+
+func TestMapPollLastN(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(0, "a")
+	m.Put(1, "b")
+	m.Put(2, "c")
+
+	assert.Empty(t, m.PollLastN(0))
+	assert.Equal(t, []Entry[int, string]{{2, "c"}, {1, "b"}}, m.PollLastN(2))
+	assert.Equal(t, []Entry[int, string]{{0, "a"}}, m.PollLastN(5))
+	assert.True(t, m.Empty())
+	assert.Empty(t, m.PollLastN(1))
+}
@@ -0,0 +1,83 @@
+package treemap
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// FirstWins resolves a key present in several merged iterators to the value of the first of them.
+// It is redblacktree.FirstWins, re-exported so that callers need not import the tree package.
+func FirstWins[K any, V any](key K, values []V) V {
+	return redblacktree.FirstWins(key, values)
+}
+
+// LastWins resolves a key present in several merged iterators to the value of the last of them.
+// It is redblacktree.LastWins, re-exported so that callers need not import the tree package.
+func LastWins[K any, V any](key K, values []V) V {
+	return redblacktree.LastWins(key, values)
+}
+
+// MergeIterator walks several map iterators sharing a comparator as a single iterator in global key order.
+type MergeIterator[K any, V any] struct {
+	iterator *redblacktree.MergeIterator[K, V]
+}
+
+// NewMergeIterator returns a stateful iterator merging the given map (or view) iterators, initialised before the first element.
+// Keys present in several iterators are yielded once with the value returned by resolve, which receives
+// the values in the order of the iterators, e.g. FirstWins, LastWins or a function combining them.
+// Views are walked in key order regardless of their own order.
+// Panics if the iterators use different comparators.
+func NewMergeIterator[K any, V any](resolve func(key K, values []V) V, iterators ...Iterator[K, V]) *MergeIterator[K, V] {
+	sources := make([]redblacktree.Iterator[K, V], len(iterators))
+	for i, iterator := range iterators {
+		sources[i] = iterator.iterator
+	}
+	return &MergeIterator[K, V]{iterator: redblacktree.NewMergeIterator(resolve, sources...)}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the merged order.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the merged order.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element's resolved value.
+// Does not modify the state of the iterator.
+func (iterator *MergeIterator[K, V]) Value() V {
+	return iterator.iterator.Value()
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *MergeIterator[K, V]) Key() K {
+	return iterator.iterator.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *MergeIterator[K, V]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *MergeIterator[K, V]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element.
+// Modifies the state of the iterator
+func (iterator *MergeIterator[K, V]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element.
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) Last() bool {
+	return iterator.iterator.Last()
+}
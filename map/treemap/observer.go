@@ -0,0 +1,23 @@
+package treemap
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// Observe registers f to be called synchronously after every mutation of the map, in registration order,
+// including the ones made through views. f must not mutate the map. Returns a function that unregisters f.
+// Clear reports a single Cleared event, while clearing a view reports a Removed event per entry.
+func (m *Map[K, V]) Observe(f func(event redblacktree.Event[K, V])) (cancel func()) {
+	return m.tree.Observe(f)
+}
+
+// Subscribe returns a subscription receiving the events of the map on a channel buffered to hold buffer events.
+// Mutations never block on the subscription: events that do not fit in the buffer are dropped and counted.
+func (m *Map[K, V]) Subscribe(buffer int) *redblacktree.Subscription[K, V] {
+	return m.tree.Subscribe(redblacktree.Range[K]{}, buffer)
+}
+
+// Subscribe returns a subscription receiving the events whose keys lie within the view's range,
+// and every Cleared event, on a channel buffered to hold buffer events.
+// Mutations never block on the subscription: events that do not fit in the buffer are dropped and counted.
+func (v *View[K, V]) Subscribe(buffer int) *redblacktree.Subscription[K, V] {
+	return v.tree.Subscribe(v.rng, buffer)
+}
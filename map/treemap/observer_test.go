@@ -0,0 +1,124 @@
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapObserve(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	var events []redblacktree.Event[int, string]
+	cancel := m.Observe(func(event redblacktree.Event[int, string]) { events = append(events, event) })
+
+	m.Put(1, "a")
+	m.Put(1, "b")
+	assert.NoError(t, m.SubMap(0, 10).Put(2, "c"))
+	m.Remove(1)
+	m.Remove(42)
+	assert.Equal(t, []redblacktree.Event[int, string]{
+		{Kind: redblacktree.Inserted, Key: 1, Value: "a"},
+		{Kind: redblacktree.Updated, Key: 1, OldValue: "a", Value: "b"},
+		{Kind: redblacktree.Inserted, Key: 2, Value: "c"},
+		{Kind: redblacktree.Removed, Key: 1, OldValue: "b"},
+	}, events)
+
+	cancel()
+	m.Put(3, "d")
+	assert.Len(t, events, 4)
+}
+
+func TestMapObserveClear(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	var events []redblacktree.Event[int, string]
+	m.Observe(func(event redblacktree.Event[int, string]) { events = append(events, event) })
+
+	m.SubMap(2, 4).Clear()
+	assert.Equal(t, []redblacktree.Event[int, string]{
+		{Kind: redblacktree.Removed, Key: 2, OldValue: "b"},
+		{Kind: redblacktree.Removed, Key: 3, OldValue: "c"},
+	}, events)
+
+	events = nil
+	m.Clear()
+	assert.Equal(t, []redblacktree.Event[int, string]{{Kind: redblacktree.Cleared}}, events)
+}
+
+func TestMapObserveCommit(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	var events []redblacktree.Event[int, string]
+	m.Observe(func(event redblacktree.Event[int, string]) { events = append(events, event) })
+
+	// a commit reports the changed keys only, not a rebuild of the map
+	txn := m.Begin()
+	txn.Put(3, "c")
+	txn.Remove(1)
+	txn.Commit()
+	assert.Equal(t, []redblacktree.Event[int, string]{
+		{Kind: redblacktree.Removed, Key: 1, OldValue: "a"},
+		{Kind: redblacktree.Inserted, Key: 3, Value: "c"},
+	}, events)
+}
+
+func TestMapSubscribeDropped(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	subscription := m.Subscribe(2)
+	defer subscription.Close()
+
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "dropped")
+	m.Remove(3)
+	assert.Equal(t, uint64(2), subscription.Dropped())
+	assert.Equal(t, redblacktree.Event[int, string]{Kind: redblacktree.Inserted, Key: 1, Value: "a"}, <-subscription.C)
+	assert.Equal(t, redblacktree.Event[int, string]{Kind: redblacktree.Inserted, Key: 2, Value: "b"}, <-subscription.C)
+
+	// a drained buffer accepts events again
+	m.Put(4, "d")
+	assert.Equal(t, 4, (<-subscription.C).Key)
+	assert.Equal(t, uint64(2), subscription.Dropped())
+}
+
+func TestMapViewSubscribe(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	subscription := m.SubMap(10, 20).Subscribe(10)
+
+	m.Put(5, "out")
+	m.Put(10, "a")
+	m.Put(20, "out")
+	assert.NoError(t, m.TailMap(15, true).Put(19, "b"))
+	m.Remove(5)
+	m.Remove(10)
+	m.Clear()
+	subscription.Close()
+
+	var events []redblacktree.Event[int, string]
+	for event := range subscription.C {
+		events = append(events, event)
+	}
+	assert.Equal(t, []redblacktree.Event[int, string]{
+		{Kind: redblacktree.Inserted, Key: 10, Value: "a"},
+		{Kind: redblacktree.Inserted, Key: 19, Value: "b"},
+		{Kind: redblacktree.Removed, Key: 10, OldValue: "a"},
+		{Kind: redblacktree.Cleared},
+	}, events)
+	assert.Equal(t, uint64(0), subscription.Dropped())
+}
+
+func TestMapDescendingViewSubscribe(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	subscription := m.HeadMap(10, false).DescendingMap().Subscribe(10)
+	defer subscription.Close()
+
+	m.Put(10, "out")
+	m.Put(3, "a")
+	assert.Equal(t, redblacktree.Event[int, string]{Kind: redblacktree.Inserted, Key: 3, Value: "a"}, <-subscription.C)
+	assert.Empty(t, subscription.C)
+}
@@ -0,0 +1,50 @@
+package treemap
+
+import (
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Page returns up to limit entries that follow the key encoded in afterToken when walking the map
+// in the given direction, together with the token of the next page.
+// An empty afterToken starts at the first entry in that direction, an empty nextToken means there are no more entries.
+// If the key of afterToken was removed in the meantime, the page resumes at its strict successor.
+// A non-positive limit returns all remaining entries.
+// Keys are encoded into tokens with the codec.
+func (m *Map[K, V]) Page(afterToken string, limit int, direction utils.Direction, codec utils.Codec[K]) (keys []K, values []V, nextToken string, err error) {
+	var node *redblacktree.Node[K, V]
+	switch {
+	case afterToken == "" && direction == utils.Descending:
+		node = m.tree.Right()
+	case afterToken == "":
+		node = m.tree.Left()
+	default:
+		key, err := utils.DecodeToken(codec, afterToken)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		if direction == utils.Descending {
+			node, _ = m.tree.Lower(key)
+		} else {
+			node, _ = m.tree.Higher(key)
+		}
+	}
+	if node == nil {
+		return
+	}
+
+	it := m.tree.IteratorAt(node)
+	next := it.Next
+	if direction == utils.Descending {
+		next = it.Prev
+	}
+	for ok := true; ok; ok = next() {
+		if limit > 0 && len(keys) == limit {
+			nextToken, err = utils.EncodeToken(codec, keys[len(keys)-1])
+			return
+		}
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	return
+}
@@ -0,0 +1,141 @@
+package treemap
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapPageAscending(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(5, "e")
+
+	keys, values, token, err := m.Page("", 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, keys)
+	assert.Equal(t, []string{"a", "b"}, values)
+	assert.NotEmpty(t, token)
+
+	keys, values, token, err = m.Page(token, 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, keys)
+	assert.Equal(t, []string{"c", "d"}, values)
+
+	keys, values, token, err = m.Page(token, 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5}, keys)
+	assert.Equal(t, []string{"e"}, values)
+	assert.Empty(t, token)
+}
+
+func TestMapPageDescending(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	keys, values, token, err := m.Page("", 2, utils.Descending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 2}, keys)
+	assert.Equal(t, []string{"c", "b"}, values)
+
+	keys, values, token, err = m.Page(token, 2, utils.Descending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, keys)
+	assert.Equal(t, []string{"a"}, values)
+	assert.Empty(t, token)
+}
+
+func TestMapPageLastPageExactlyFull(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	keys, _, token, err := m.Page("", 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, keys)
+	assert.Empty(t, token)
+
+	keys, _, token, err = m.Page("", 0, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, keys)
+	assert.Empty(t, token)
+}
+
+func TestMapPageEmpty(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+
+	keys, values, token, err := m.Page("", 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Empty(t, values)
+	assert.Empty(t, token)
+}
+
+func TestMapPageMalformedToken(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+
+	_, _, _, err := m.Page("not base64!", 2, utils.Ascending, utils.IntCodec{})
+	assert.Error(t, err)
+
+	_, _, _, err = m.Page(base64.RawURLEncoding.EncodeToString([]byte("\x01x")), 2, utils.Ascending, utils.IntCodec{})
+	assert.Error(t, err)
+
+	// a bare key without the token version
+	_, _, _, err = m.Page(base64.RawURLEncoding.EncodeToString([]byte("1")), 2, utils.Ascending, utils.IntCodec{})
+	assert.Error(t, err)
+}
+
+func TestMapPageAnchorRemoved(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	m.Put(4, "d")
+
+	_, _, token, err := m.Page("", 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	m.Remove(2)
+	keys, _, _, err := m.Page(token, 2, utils.Ascending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, keys)
+
+	_, _, token, err = m.Page("", 2, utils.Descending, utils.IntCodec{})
+	assert.NoError(t, err)
+	m.Remove(3)
+	keys, _, _, err = m.Page(token, 2, utils.Descending, utils.IntCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, keys)
+}
+
+func TestMapPageEmptyKey(t *testing.T) {
+	m := NewWithComparator[string, int](utils.StringComparator)
+	m.Put("", 0)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys, _, token, err := m.Page("", 1, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, keys)
+	assert.NotEmpty(t, token)
+
+	keys, _, token, err = m.Page(token, 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Empty(t, token)
+
+	keys, _, token, err = m.Page("", 2, utils.Descending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "a"}, keys)
+	keys, _, token, err = m.Page(token, 2, utils.Descending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, keys)
+	assert.Empty(t, token)
+}
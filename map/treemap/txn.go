@@ -0,0 +1,241 @@
+package treemap
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// Txn is a batch of changes to a map that is applied all at once by Commit or dropped by Rollback.
+// Pending changes are kept in an overlay tree over the map, so reads see them while the map is left untouched.
+// The map must not be modified while the transaction is pending.
+type Txn[K any, V any] struct {
+	base    *Map[K, V]
+	overlay *redblacktree.Tree[K, pending[V]]
+	delta   int // size change of the pending changes
+}
+
+// pending is a change in the overlay, either a new value or a removal.
+type pending[V any] struct {
+	value   V
+	removed bool
+}
+
+// Begin starts a transaction on the map.
+func (m *Map[K, V]) Begin() *Txn[K, V] {
+	return &Txn[K, V]{base: m, overlay: redblacktree.NewWithComparator[K, pending[V]](m.tree.Comparator)}
+}
+
+// Put records the insertion of the key-value pair.
+// Panics if the transaction is finished.
+func (txn *Txn[K, V]) Put(key K, value V) {
+	if _, found := txn.Get(key); !found {
+		txn.delta++
+	}
+	txn.overlay.Put(key, pending[V]{value: value})
+}
+
+// Remove records the removal of the key, if it is present.
+// Panics if the transaction is finished.
+func (txn *Txn[K, V]) Remove(key K) {
+	if _, found := txn.Get(key); !found {
+		return
+	}
+	txn.delta--
+	if _, found := txn.base.tree.Get(key); found {
+		txn.overlay.Put(key, pending[V]{removed: true})
+	} else {
+		txn.overlay.Remove(key)
+	}
+}
+
+// Get returns the value of the key as seen by the transaction, its pending changes overlaid on the map.
+// Second return parameter is true if key was found, otherwise false.
+// Panics if the transaction is finished.
+func (txn *Txn[K, V]) Get(key K) (value V, found bool) {
+	txn.checkPending()
+	if change, found := txn.overlay.Get(key); found {
+		return change.value, !change.removed
+	}
+	return txn.base.tree.Get(key)
+}
+
+// Size returns the number of elements as seen by the transaction.
+func (txn *Txn[K, V]) Size() int {
+	txn.checkPending()
+	return txn.base.Size() + txn.delta
+}
+
+// Empty returns true if the transaction sees no elements.
+func (txn *Txn[K, V]) Empty() bool {
+	return txn.Size() == 0
+}
+
+// Keys returns all keys in-order as seen by the transaction.
+func (txn *Txn[K, V]) Keys() []K {
+	keys := make([]K, 0, txn.Size())
+	for it := txn.Iterator(); it.Next(); {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key as seen by the transaction.
+func (txn *Txn[K, V]) Values() []V {
+	values := make([]V, 0, txn.Size())
+	for it := txn.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Commit applies the pending changes to the map in key order and finishes the transaction.
+// Panics if the transaction is finished.
+func (txn *Txn[K, V]) Commit() {
+	txn.checkPending()
+	for it := txn.overlay.Iterator(); it.Next(); {
+		if change := it.Value(); change.removed {
+			txn.base.tree.Remove(it.Key())
+		} else {
+			txn.base.tree.Put(it.Key(), change.value)
+		}
+	}
+	txn.overlay = nil
+}
+
+// Rollback drops the pending changes in O(1) and finishes the transaction.
+// Rolling back a finished transaction does nothing, so it can be deferred right after Begin.
+func (txn *Txn[K, V]) Rollback() {
+	txn.overlay = nil
+}
+
+func (txn *Txn[K, V]) checkPending() {
+	if txn.overlay == nil {
+		panic("treemap: transaction is finished")
+	}
+}
+
+// TxnIterator iterates over the elements seen by a transaction, merging its pending changes with the map.
+// Every step seeks both trees, so it runs in O(log n).
+type TxnIterator[K any, V any] struct {
+	txn      *Txn[K, V]
+	key      K
+	value    V
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator over the elements seen by the transaction, in key order.
+func (txn *Txn[K, V]) Iterator() *TxnIterator[K, V] {
+	txn.checkPending()
+	return &TxnIterator[K, V]{txn: txn, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *TxnIterator[K, V]) Next() bool {
+	return iterator.step(true)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *TxnIterator[K, V]) Prev() bool {
+	return iterator.step(false)
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *TxnIterator[K, V]) Key() K {
+	return iterator.key
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *TxnIterator[K, V]) Value() V {
+	return iterator.value
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *TxnIterator[K, V]) Begin() {
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *TxnIterator[K, V]) End() {
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element.
+// Modifies the state of the iterator.
+func (iterator *TxnIterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element.
+// Modifies the state of the iterator.
+func (iterator *TxnIterator[K, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// step moves to the closest key in the direction among both trees, preferring the overlay on ties
+// and skipping the keys removed by the transaction.
+func (iterator *TxnIterator[K, V]) step(forward bool) bool {
+	if forward && iterator.position == end || !forward && iterator.position == begin {
+		return false
+	}
+	txn := iterator.txn
+	txn.checkPending()
+	comparator := txn.base.tree.Comparator
+	for {
+		var baseNode *redblacktree.Node[K, V]
+		var overlayNode *redblacktree.Node[K, pending[V]]
+		switch {
+		case iterator.position != between && forward:
+			baseNode, overlayNode = txn.base.tree.Left(), txn.overlay.Left()
+		case iterator.position != between:
+			baseNode, overlayNode = txn.base.tree.Right(), txn.overlay.Right()
+		case forward:
+			baseNode, _ = txn.base.tree.Higher(iterator.key)
+			overlayNode, _ = txn.overlay.Higher(iterator.key)
+		default:
+			baseNode, _ = txn.base.tree.Lower(iterator.key)
+			overlayNode, _ = txn.overlay.Lower(iterator.key)
+		}
+		if baseNode == nil && overlayNode == nil {
+			if forward {
+				iterator.position = end
+			} else {
+				iterator.position = begin
+			}
+			return false
+		}
+		iterator.position = between
+		if overlayNode != nil {
+			compare := 0
+			if baseNode != nil {
+				compare = comparator(overlayNode.Key, baseNode.Key)
+				if !forward {
+					compare = -compare
+				}
+			}
+			if compare <= 0 {
+				iterator.key = overlayNode.Key
+				if overlayNode.Value.removed {
+					continue
+				}
+				iterator.value = overlayNode.Value.value
+				return true
+			}
+		}
+		iterator.key, iterator.value = baseNode.Key, baseNode.Value
+		return true
+	}
+}
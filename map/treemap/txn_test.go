@@ -0,0 +1,199 @@
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapTxnOverlay(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+
+	txn := m.Begin()
+	txn.Put(2, "b")
+	txn.Put(3, "C")
+	txn.Put(6, "f")
+
+	assert.Equal(t, 5, txn.Size())
+	assert.Equal(t, []int{1, 2, 3, 5, 6}, txn.Keys())
+	assert.Equal(t, []string{"a", "b", "C", "e", "f"}, txn.Values())
+	value, found := txn.Get(3)
+	assert.Equal(t, "C", value)
+	assert.True(t, found)
+
+	// the map is left untouched until commit
+	assert.Equal(t, []int{1, 3, 5}, m.Keys())
+	assert.Equal(t, []string{"a", "c", "e"}, m.Values())
+}
+
+func TestMapTxnRemove(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+
+	txn := m.Begin()
+	txn.Remove(3)
+	txn.Remove(4) // absent, does nothing
+	txn.Put(2, "b")
+	txn.Remove(2) // pending insertion, dropped from the overlay
+
+	assert.Equal(t, 2, txn.Size())
+	assert.Equal(t, []int{1, 5}, txn.Keys())
+	_, found := txn.Get(3)
+	assert.False(t, found)
+	_, found = txn.Get(2)
+	assert.False(t, found)
+
+	txn.Put(3, "C") // put over a removal marker
+	assert.Equal(t, 3, txn.Size())
+	assert.Equal(t, []string{"a", "C", "e"}, txn.Values())
+
+	txn.Remove(1)
+	txn.Remove(3)
+	txn.Remove(5)
+	assert.True(t, txn.Empty())
+	assert.Empty(t, txn.Keys())
+}
+
+func TestMapTxnIterator(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+	m.Put(7, "g")
+
+	txn := m.Begin()
+	txn.Put(2, "b")
+	txn.Remove(3)
+	txn.Put(4, "d")
+	txn.Remove(5)
+	txn.Put(7, "G")
+
+	var keys []int
+	var values []string
+	it := txn.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []int{1, 2, 4, 7}, keys)
+	assert.Equal(t, []string{"a", "b", "d", "G"}, values)
+	assert.False(t, it.Next())
+
+	keys, values = nil, nil
+	for it.Prev() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []int{7, 4, 2, 1}, keys)
+	assert.Equal(t, []string{"G", "d", "b", "a"}, values)
+	assert.False(t, it.Prev())
+
+	// change direction in the middle, across removal markers
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.Equal(t, 4, it.Key())
+	assert.True(t, it.Prev())
+	assert.Equal(t, 2, it.Key())
+
+	assert.True(t, it.Last())
+	assert.Equal(t, 7, it.Key())
+	assert.True(t, it.First())
+	assert.Equal(t, 1, it.Key())
+}
+
+func TestMapTxnIteratorRemovedEnds(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	txn := m.Begin()
+	txn.Remove(1)
+	txn.Remove(3)
+
+	it := txn.Iterator()
+	assert.True(t, it.First())
+	assert.Equal(t, 2, it.Key())
+	assert.False(t, it.Next())
+	assert.True(t, it.Last())
+	assert.Equal(t, 2, it.Key())
+	assert.False(t, it.Prev())
+
+	txn.Remove(2)
+	assert.False(t, txn.Iterator().First())
+	assert.False(t, txn.Iterator().Last())
+}
+
+func TestMapTxnCommit(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+
+	txn := m.Begin()
+	txn.Put(4, "d")
+	txn.Remove(3)
+	txn.Put(1, "A")
+	txn.Put(2, "b")
+
+	var events []redblacktree.Event[int, string]
+	m.Observe(func(event redblacktree.Event[int, string]) {
+		events = append(events, event)
+	})
+	txn.Commit()
+
+	assert.Equal(t, []int{1, 2, 4, 5}, m.Keys())
+	assert.Equal(t, []string{"A", "b", "d", "e"}, m.Values())
+	assert.Equal(t, []redblacktree.Event[int, string]{
+		{Kind: redblacktree.Updated, Key: 1, OldValue: "a", Value: "A"},
+		{Kind: redblacktree.Inserted, Key: 2, Value: "b"},
+		{Kind: redblacktree.Removed, Key: 3, OldValue: "c"},
+		{Kind: redblacktree.Inserted, Key: 4, Value: "d"},
+	}, events)
+}
+
+func TestMapTxnRollback(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	m.Put(3, "c")
+
+	txn := m.Begin()
+	txn.Put(2, "b")
+	txn.Remove(1)
+	txn.Rollback()
+
+	assert.Equal(t, []int{1, 3}, m.Keys())
+	assert.Equal(t, []string{"a", "c"}, m.Values())
+}
+
+func TestMapTxnFinished(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+
+	txn := m.Begin()
+	it := txn.Iterator()
+	txn.Commit()
+
+	assert.NotPanics(t, txn.Rollback)
+	assert.PanicsWithValue(t, "treemap: transaction is finished", txn.Commit)
+	assert.PanicsWithValue(t, "treemap: transaction is finished", func() { txn.Put(2, "b") })
+	assert.PanicsWithValue(t, "treemap: transaction is finished", func() { txn.Remove(1) })
+	assert.PanicsWithValue(t, "treemap: transaction is finished", func() { txn.Get(1) })
+	assert.PanicsWithValue(t, "treemap: transaction is finished", func() { txn.Size() })
+	assert.PanicsWithValue(t, "treemap: transaction is finished", func() { txn.Iterator() })
+	assert.PanicsWithValue(t, "treemap: transaction is finished", func() { it.Next() })
+
+	txn = m.Begin()
+	txn.Rollback()
+	assert.NotPanics(t, txn.Rollback)
+	assert.PanicsWithValue(t, "treemap: transaction is finished", txn.Commit)
+	assert.Equal(t, []int{1}, m.Keys())
+}
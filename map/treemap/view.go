@@ -0,0 +1,331 @@
+package treemap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+)
+
+// ErrOutOfRange is returned when a key outside of a view's range is put into the view.
+var ErrOutOfRange = errors.New("treemap: key out of view range")
+
+// View is a live view of a key range of a map.
+// It is backed by the same tree as the map, so changes of the map are reflected in the view and vice versa.
+// Iteration follows the order of the view, while Min, Max, Floor and Ceiling follow the order of the comparator.
+type View[K any, V any] struct {
+	tree *redblacktree.Tree[K, V]
+	rng  redblacktree.Range[K]
+}
+
+// HeadMap returns a view of the part of the map whose keys are less than (or equal to, if inclusive is true) to.
+func (m *Map[K, V]) HeadMap(to K, inclusive bool) *View[K, V] {
+	return m.view().HeadMap(to, inclusive)
+}
+
+// TailMap returns a view of the part of the map whose keys are greater than (or equal to, if inclusive is true) from.
+func (m *Map[K, V]) TailMap(from K, inclusive bool) *View[K, V] {
+	return m.view().TailMap(from, inclusive)
+}
+
+// SubMap returns a view of the part of the map whose keys range from from, inclusive, to to, exclusive.
+func (m *Map[K, V]) SubMap(from, to K) *View[K, V] {
+	return m.view().SubMap(from, to)
+}
+
+// DescendingMap returns a view of the map in reverse order.
+func (m *Map[K, V]) DescendingMap() *View[K, V] {
+	return m.view().DescendingMap()
+}
+
+func (m *Map[K, V]) view() *View[K, V] {
+	return &View[K, V]{tree: m.tree}
+}
+
+// HeadMap returns a view of the part of this view whose keys come before to in the view's order.
+// The key to itself is included if inclusive is true.
+func (v *View[K, V]) HeadMap(to K, inclusive bool) *View[K, V] {
+	bound := redblacktree.Exclusive(to)
+	if inclusive {
+		bound = redblacktree.Inclusive(to)
+	}
+	if v.rng.Descending {
+		return v.narrow(bound, redblacktree.Bound[K]{})
+	}
+	return v.narrow(redblacktree.Bound[K]{}, bound)
+}
+
+// TailMap returns a view of the part of this view whose keys come after from in the view's order.
+// The key from itself is included if inclusive is true.
+func (v *View[K, V]) TailMap(from K, inclusive bool) *View[K, V] {
+	bound := redblacktree.Exclusive(from)
+	if inclusive {
+		bound = redblacktree.Inclusive(from)
+	}
+	if v.rng.Descending {
+		return v.narrow(redblacktree.Bound[K]{}, bound)
+	}
+	return v.narrow(bound, redblacktree.Bound[K]{})
+}
+
+// SubMap returns a view of the part of this view whose keys range from from, inclusive, to to, exclusive,
+// both in the view's order.
+func (v *View[K, V]) SubMap(from, to K) *View[K, V] {
+	return v.TailMap(from, true).HeadMap(to, false)
+}
+
+// DescendingMap returns a view of this view in reverse order.
+func (v *View[K, V]) DescendingMap() *View[K, V] {
+	rng := v.rng
+	rng.Descending = !rng.Descending
+	return &View[K, V]{tree: v.tree, rng: rng}
+}
+
+func (v *View[K, V]) narrow(lower, upper redblacktree.Bound[K]) *View[K, V] {
+	return &View[K, V]{tree: v.tree, rng: v.tree.IntersectRange(v.rng, lower, upper)}
+}
+
+// Put inserts key-value pair into the underlying map.
+// Returns ErrOutOfRange and leaves the map unchanged if the key lies outside of the view.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) Put(key K, value V) error {
+	if !v.tree.InRange(v.rng, key) {
+		return ErrOutOfRange
+	}
+	v.tree.Put(key, value)
+	return nil
+}
+
+// Get searches the element in the view by key and returns its value or zero value if key is not found.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) Get(key K) (value V, found bool) {
+	if !v.tree.InRange(v.rng, key) {
+		return
+	}
+	return v.tree.Get(key)
+}
+
+// Remove removes the element from the underlying map by key, keys outside of the view are ignored.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) Remove(key K) {
+	if v.tree.InRange(v.rng, key) {
+		v.tree.Remove(key)
+	}
+}
+
+// Empty returns true if view does not contain any elements.
+func (v *View[K, V]) Empty() bool {
+	return v.tree.RangeFirst(v.rng) == nil
+}
+
+// Size returns number of elements in the view.
+// Runs in time proportional to the number of elements in the view.
+func (v *View[K, V]) Size() int {
+	return v.tree.RangeSize(v.rng)
+}
+
+// Keys returns all keys in the view's order.
+func (v *View[K, V]) Keys() []K {
+	var keys []K
+	it := v.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns all values in the view's order based on the key.
+func (v *View[K, V]) Values() []V {
+	var values []V
+	it := v.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clear removes all elements of the view from the underlying map.
+func (v *View[K, V]) Clear() {
+	for node := v.tree.RangeFirst(v.rng); node != nil; node = v.tree.RangeFirst(v.rng) {
+		v.tree.Remove(node.Key)
+	}
+}
+
+// ReversedValues returns all values in the reverse of the view's order based on the key.
+func (v *View[K, V]) ReversedValues() []V {
+	var values []V
+	it := v.Iterator()
+	for it.End(); it.Prev(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Min returns the minimum key of the view and its value.
+// Returns zero values if view is empty, use MinEntry to tell that apart from a zero key.
+func (v *View[K, V]) Min() (key K, value V) {
+	entry, _ := v.MinEntry()
+	return entry.Key, entry.Value
+}
+
+// Max returns the maximum key of the view and its value.
+// Returns zero values if view is empty, use MaxEntry to tell that apart from a zero key.
+func (v *View[K, V]) Max() (key K, value V) {
+	entry, _ := v.MaxEntry()
+	return entry.Key, entry.Value
+}
+
+// Floor finds the floor key-value pair of the view for the input key.
+// In case that no floor is found, then both returned values will be zero values.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) Floor(key K) (foundkey K, foundvalue V) {
+	entry, _ := v.FloorEntry(key)
+	return entry.Key, entry.Value
+}
+
+// Ceiling finds the ceiling key-value pair of the view for the input key.
+// In case that no ceiling is found, then both returned values will be zero values.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) Ceiling(key K) (foundkey K, foundvalue V) {
+	entry, _ := v.CeilingEntry(key)
+	return entry.Key, entry.Value
+}
+
+// MinEntry returns the entry with the minimum key of the view.
+// Second return parameter is true if view is not empty, otherwise false.
+func (v *View[K, V]) MinEntry() (entry Entry[K, V], found bool) {
+	rng := v.rng
+	rng.Descending = false
+	if node := v.tree.RangeFirst(rng); node != nil {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
+// MaxEntry returns the entry with the maximum key of the view.
+// Second return parameter is true if view is not empty, otherwise false.
+func (v *View[K, V]) MaxEntry() (entry Entry[K, V], found bool) {
+	rng := v.rng
+	rng.Descending = false
+	if node := v.tree.RangeLast(rng); node != nil {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
+// FloorEntry finds the entry of the view with the largest key that is smaller than or equal to the given key.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) FloorEntry(key K) (entry Entry[K, V], found bool) {
+	if node, found := v.tree.RangeFloor(v.rng, key); found {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
+// CeilingEntry finds the entry of the view with the smallest key that is larger than or equal to the given key.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View[K, V]) CeilingEntry(key K) (entry Entry[K, V], found bool) {
+	if node, found := v.tree.RangeCeiling(v.rng, key); found {
+		return Entry[K, V]{Key: node.Key, Value: node.Value}, true
+	}
+	return
+}
+
+// Iterator returns a stateful iterator over the view whose elements are key/value pairs.
+func (v *View[K, V]) Iterator() Iterator[K, V] {
+	return Iterator[K, V]{iterator: v.tree.RangeIterator(v.rng)}
+}
+
+// Each calls the given function once for each element, passing that element's key and value.
+func (v *View[K, V]) Each(f func(key K, value V)) {
+	iterator := v.Iterator()
+	for iterator.Next() {
+		f(iterator.Key(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a new map
+// containing the values returned by the given function as key/value pairs.
+func (v *View[K, V]) Map(f func(key1 K, value1 V) (K, V)) *Map[K, V] {
+	newMap := &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](v.tree.Comparator)}
+	iterator := v.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		newMap.Put(key2, value2)
+	}
+	return newMap
+}
+
+// Select returns a new map containing all elements for which the given function returns a true value.
+func (v *View[K, V]) Select(f func(key K, value V) bool) *Map[K, V] {
+	newMap := &Map[K, V]{tree: redblacktree.NewWithComparator[K, V](v.tree.Comparator)}
+	iterator := v.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			newMap.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return newMap
+}
+
+// Any passes each element of the view to the given function and
+// returns true if the function ever returns true for any element.
+func (v *View[K, V]) Any(f func(key K, value V) bool) bool {
+	iterator := v.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the view to the given function and
+// returns true if the function returns true for all elements.
+func (v *View[K, V]) All(f func(key K, value V) bool) bool {
+	iterator := v.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Key(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the view to the given function and returns
+// the first (key,value) in the view's order for which the function is true or zero values otherwise.
+func (v *View[K, V]) Find(f func(key K, value V) bool) (k K, val V) {
+	entry, _ := v.FindEntry(f)
+	return entry.Key, entry.Value
+}
+
+// FindEntry passes each element of the view to the given function and returns
+// the first entry in the view's order for which the function is true.
+// Second return parameter is true if an element matches the criteria, otherwise false.
+func (v *View[K, V]) FindEntry(f func(key K, value V) bool) (entry Entry[K, V], found bool) {
+	iterator := v.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return Entry[K, V]{Key: iterator.Key(), Value: iterator.Value()}, true
+		}
+	}
+	return
+}
+
+// String returns a string representation of container
+func (v *View[K, V]) String() string {
+	str := "TreeMapView\nmap["
+	it := v.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
@@ -0,0 +1,116 @@
+package treemap
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapViewBounds(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for i := 1; i <= 5; i++ {
+		m.Put(i, string(rune('a'+i-1)))
+	}
+
+	assert.Equal(t, []int{1, 2}, m.HeadMap(3, false).Keys())
+	assert.Equal(t, []int{1, 2, 3}, m.HeadMap(3, true).Keys())
+	assert.Equal(t, []int{4, 5}, m.TailMap(3, false).Keys())
+	assert.Equal(t, []int{3, 4, 5}, m.TailMap(3, true).Keys())
+	assert.Equal(t, []int{2, 3}, m.SubMap(2, 4).Keys())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, m.DescendingMap().Keys())
+	assert.Equal(t, 2, m.SubMap(2, 4).Size())
+}
+
+func TestMapViewPutOutOfRange(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	m.Put(1, "a")
+	view := m.SubMap(2, 4)
+
+	assert.ErrorIs(t, view.Put(1, "x"), ErrOutOfRange)
+	assert.ErrorIs(t, view.Put(4, "x"), ErrOutOfRange)
+	assert.NoError(t, view.Put(2, "b"))
+	assert.Equal(t, []int{1, 2}, m.Keys())
+	assert.Equal(t, []string{"a", "b"}, m.Values())
+
+	// removals outside of the view are ignored
+	view.Remove(1)
+	assert.Equal(t, []int{1, 2}, m.Keys())
+}
+
+func TestMapViewReflectsWrites(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	view := m.TailMap(2, true)
+	assert.True(t, view.Empty())
+
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	assert.Equal(t, []int{2, 3}, view.Keys())
+	value, found := view.Get(3)
+	assert.Equal(t, "c", value)
+	assert.True(t, found)
+	_, found = view.Get(1)
+	assert.False(t, found)
+
+	m.Remove(2)
+	entry, found := view.MinEntry()
+	assert.Equal(t, Entry[int, string]{3, "c"}, entry)
+	assert.True(t, found)
+
+	view.Clear()
+	assert.Equal(t, []int{1}, m.Keys())
+}
+
+func TestMapViewOfDescendingMap(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for i := 1; i <= 6; i++ {
+		m.Put(i, string(rune('a'+i-1)))
+	}
+	descending := m.DescendingMap()
+
+	// bounds follow the order of the view
+	assert.Equal(t, []int{5, 4, 3}, descending.SubMap(5, 2).Keys())
+	assert.Equal(t, []int{6, 5, 4}, descending.HeadMap(4, true).Keys())
+	assert.Equal(t, []int{3, 2, 1}, descending.TailMap(4, false).Keys())
+	assert.Equal(t, []int{3, 4, 5}, descending.SubMap(5, 2).DescendingMap().Keys())
+
+	// Min and Max follow the order of the comparator
+	key, _ := descending.SubMap(5, 2).Min()
+	assert.Equal(t, 3, key)
+	key, _ = descending.SubMap(5, 2).Max()
+	assert.Equal(t, 5, key)
+
+	assert.ErrorIs(t, descending.SubMap(5, 2).Put(2, "x"), ErrOutOfRange)
+}
+
+func TestMapViewIterator(t *testing.T) {
+	m := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for i := 1; i <= 5; i++ {
+		m.Put(i, string(rune('a'+i-1)))
+	}
+
+	tests := []struct {
+		view     *View[int, string]
+		backward []int
+	}{
+		{m.SubMap(2, 5), []int{4, 3, 2}},
+		{m.DescendingMap().SubMap(4, 1), []int{2, 3, 4}},
+		{m.HeadMap(0, false), nil},
+	}
+
+	for _, test := range tests {
+		var keys []int
+		it := test.view.Iterator()
+		for it.End(); it.Prev(); {
+			keys = append(keys, it.Key())
+		}
+		assert.Equal(t, test.backward, keys)
+
+		keys = nil
+		for ok := it.Last(); ok; ok = it.Prev() {
+			keys = append(keys, it.Key())
+		}
+		assert.Equal(t, test.backward, keys)
+	}
+}
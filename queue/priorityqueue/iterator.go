@@ -0,0 +1,73 @@
+package priorityqueue
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// Iterator holding the iterator's state
+type Iterator[P any, V any] struct {
+	iterator redblacktree.Iterator[slot[P], *Handle[P, V]]
+}
+
+// Iterator returns a stateful iterator over the items in the order they would be popped.
+// The queue must not be modified while iterating.
+func (queue *Queue[P, V]) Iterator() Iterator[P, V] {
+	return Iterator[P, V]{iterator: queue.tree.Iterator()}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's handle, priority and value can be retrieved by Handle(), Priority() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[P, V]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's handle, priority and value can be retrieved by Handle(), Priority() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[P, V]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Handle returns the current element's handle.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[P, V]) Handle() *Handle[P, V] {
+	return iterator.iterator.Value()
+}
+
+// Priority returns the current element's priority.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[P, V]) Priority() P {
+	return iterator.iterator.Key().priority
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[P, V]) Value() V {
+	return iterator.iterator.Value().value
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[P, V]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[P, V]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's handle, priority and value can be retrieved by Handle(), Priority() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[P, V]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's handle, priority and value can be retrieved by Handle(), Priority() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[P, V]) Last() bool {
+	return iterator.iterator.Last()
+}
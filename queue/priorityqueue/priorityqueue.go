@@ -0,0 +1,181 @@
+// Package priorityqueue provides a priority queue backed by a red-black tree.
+//
+// Items are keyed by their priority and an insertion sequence number, so items of equal priority
+// are served first-in first-out, and every item is reachable through the handle returned on Push
+// to update its priority or remove it in O(log n).
+package priorityqueue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Queue holds the items in a red-black tree ordered by priority, lowest first, then by insertion.
+type Queue[P any, V any] struct {
+	tree     *redblacktree.Tree[slot[P], *Handle[P, V]]
+	sequence uint64
+}
+
+// slot is the position of an item in the tree.
+type slot[P any] struct {
+	priority P
+	sequence uint64
+}
+
+// Handle refers to an item pushed to a queue.
+type Handle[P any, V any] struct {
+	slot  slot[P]
+	value V
+	queue *Queue[P, V]
+}
+
+// Priority returns the priority of the item.
+func (handle *Handle[P, V]) Priority() P {
+	return handle.slot.priority
+}
+
+// Value returns the item.
+func (handle *Handle[P, V]) Value() V {
+	return handle.value
+}
+
+// Queued returns true if the item is still in its queue.
+func (handle *Handle[P, V]) Queued() bool {
+	return handle.queue != nil
+}
+
+// New instantiates a priority queue ordering priorities with the custom comparator, the lowest first.
+func New[P any, V any](comparator utils.Comparator[P]) *Queue[P, V] {
+	return &Queue[P, V]{tree: redblacktree.NewWithComparator[slot[P], *Handle[P, V]](func(a, b slot[P]) int {
+		if compare := comparator(a.priority, b.priority); compare != 0 {
+			return compare
+		}
+		switch {
+		case a.sequence < b.sequence:
+			return -1
+		case a.sequence > b.sequence:
+			return 1
+		default:
+			return 0
+		}
+	})}
+}
+
+// Push adds the item with the given priority and returns its handle.
+// Items of equal priority are served in the order they were pushed.
+func (queue *Queue[P, V]) Push(priority P, value V) *Handle[P, V] {
+	handle := &Handle[P, V]{slot: queue.next(priority), value: value, queue: queue}
+	queue.tree.Put(handle.slot, handle)
+	return handle
+}
+
+// Peek returns the handle of the item with the lowest priority without removing it.
+// Second return parameter is true if queue was not empty, otherwise false.
+func (queue *Queue[P, V]) Peek() (handle *Handle[P, V], found bool) {
+	if node := queue.tree.Left(); node != nil {
+		return node.Value, true
+	}
+	return nil, false
+}
+
+// PeekMax returns the handle of the item with the highest priority without removing it.
+// Among items of equal priority it is the last pushed one.
+// Second return parameter is true if queue was not empty, otherwise false.
+func (queue *Queue[P, V]) PeekMax() (handle *Handle[P, V], found bool) {
+	if node := queue.tree.Right(); node != nil {
+		return node.Value, true
+	}
+	return nil, false
+}
+
+// Pop removes the item with the lowest priority and returns its handle.
+// Second return parameter is true if queue was not empty, otherwise false.
+func (queue *Queue[P, V]) Pop() (handle *Handle[P, V], found bool) {
+	node, found := queue.tree.RemoveMin()
+	if !found {
+		return nil, false
+	}
+	node.Value.queue = nil
+	return node.Value, true
+}
+
+// PopMax removes the item with the highest priority and returns its handle.
+// Among items of equal priority it is the last pushed one.
+// Second return parameter is true if queue was not empty, otherwise false.
+func (queue *Queue[P, V]) PopMax() (handle *Handle[P, V], found bool) {
+	node, found := queue.tree.RemoveMax()
+	if !found {
+		return nil, false
+	}
+	node.Value.queue = nil
+	return node.Value, true
+}
+
+// Update changes the priority of the item in O(log n), queuing it after the items already of that priority.
+// Returns false if the item is not in this queue anymore.
+func (queue *Queue[P, V]) Update(handle *Handle[P, V], priority P) bool {
+	if handle.queue != queue {
+		return false
+	}
+	queue.tree.Remove(handle.slot)
+	handle.slot = queue.next(priority)
+	queue.tree.Put(handle.slot, handle)
+	return true
+}
+
+// Remove removes the item from the queue in O(log n).
+// Returns false if the item is not in this queue anymore.
+func (queue *Queue[P, V]) Remove(handle *Handle[P, V]) bool {
+	if handle.queue != queue {
+		return false
+	}
+	queue.tree.Remove(handle.slot)
+	handle.queue = nil
+	return true
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[P, V]) Empty() bool {
+	return queue.tree.Empty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[P, V]) Size() int {
+	return queue.tree.Size()
+}
+
+// Values returns all items in the order they would be popped.
+func (queue *Queue[P, V]) Values() []V {
+	values := make([]V, 0, queue.tree.Size())
+	for it := queue.tree.Iterator(); it.Next(); {
+		values = append(values, it.Value().value)
+	}
+	return values
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[P, V]) Clear() {
+	for it := queue.tree.Iterator(); it.Next(); {
+		it.Value().queue = nil
+	}
+	queue.tree.Clear()
+}
+
+// String returns a string representation of container
+func (queue *Queue[P, V]) String() string {
+	str := "PriorityQueue\n"
+	var items []string
+	for it := queue.tree.Iterator(); it.Next(); {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key().priority, it.Value().value))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+func (queue *Queue[P, V]) next(priority P) slot[P] {
+	queue.sequence++
+	return slot[P]{priority: priority, sequence: queue.sequence}
+}
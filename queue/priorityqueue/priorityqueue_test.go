@@ -0,0 +1,197 @@
+package priorityqueue
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityQueueFIFOTies(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	queue.Push(2, "b1")
+	queue.Push(1, "a")
+	queue.Push(2, "b2")
+	queue.Push(3, "c")
+	queue.Push(2, "b3")
+	assert.Equal(t, []string{"a", "b1", "b2", "b3", "c"}, queue.Values())
+	assert.Equal(t, "PriorityQueue\n1:a, 2:b1, 2:b2, 2:b3, 3:c", queue.String())
+
+	handle, found := queue.Peek()
+	assert.True(t, found)
+	assert.Equal(t, "a", handle.Value())
+	handle, _ = queue.PeekMax()
+	assert.Equal(t, "c", handle.Value())
+
+	var popped []string
+	for handle, found := queue.Pop(); found; handle, found = queue.Pop() {
+		assert.False(t, handle.Queued())
+		popped = append(popped, handle.Value())
+	}
+	assert.Equal(t, []string{"a", "b1", "b2", "b3", "c"}, popped)
+	_, found = queue.PopMax()
+	assert.False(t, found)
+	_, found = queue.Peek()
+	assert.False(t, found)
+}
+
+func TestPriorityQueueFIFOTiesAfterPop(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	queue.Push(1, "a1")
+	queue.Push(1, "a2")
+	queue.Pop()
+
+	// items pushed later queue behind the remaining ones of their priority
+	queue.Push(1, "a3")
+	queue.Push(0, "z")
+	assert.Equal(t, []string{"z", "a2", "a3"}, queue.Values())
+	assert.Equal(t, 3, queue.Size())
+}
+
+func TestPriorityQueuePopMax(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	queue.Push(2, "b1")
+	queue.Push(3, "c")
+	queue.Push(2, "b2")
+	queue.Push(1, "a")
+
+	var popped []string
+	for handle, found := queue.PopMax(); found; handle, found = queue.PopMax() {
+		assert.False(t, handle.Queued())
+		popped = append(popped, handle.Value())
+	}
+	// among equal priorities the last pushed item is the maximum
+	assert.Equal(t, []string{"c", "b2", "b1", "a"}, popped)
+	assert.True(t, queue.Empty())
+	_, found := queue.PeekMax()
+	assert.False(t, found)
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	a := queue.Push(5, "a")
+	queue.Push(3, "b")
+	queue.Push(4, "c")
+	d := queue.Push(1, "d")
+
+	assert.True(t, queue.Update(a, 1))
+	assert.Equal(t, 1, a.Priority())
+	assert.True(t, a.Queued())
+	// an updated item queues behind the items already of its new priority
+	assert.Equal(t, []string{"d", "a", "b", "c"}, queue.Values())
+
+	assert.True(t, queue.Update(d, 9))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, queue.Values())
+	assert.Equal(t, 4, queue.Size())
+
+}
+
+func TestPriorityQueueUpdateSamePriority(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	a := queue.Push(1, "a")
+	queue.Push(1, "b")
+	queue.Push(2, "c")
+
+	// keeping the priority still moves the item behind its peers
+	assert.True(t, queue.Update(a, 1))
+	assert.Equal(t, []string{"b", "a", "c"}, queue.Values())
+}
+
+func TestPriorityQueueUpdateDequeued(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	a := queue.Push(1, "a")
+	b := queue.Push(2, "b")
+
+	queue.Pop()
+	assert.False(t, queue.Update(a, 0))
+	assert.Equal(t, 1, a.Priority())
+
+	other := New[int, string](utils.NumbersComparator[int])
+	assert.False(t, other.Update(b, 0))
+	assert.Equal(t, []string{"b"}, queue.Values())
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	a := queue.Push(1, "a")
+	b := queue.Push(1, "b")
+	c := queue.Push(2, "c")
+
+	assert.True(t, queue.Remove(b))
+	assert.False(t, b.Queued())
+	assert.False(t, queue.Remove(b))
+	assert.False(t, queue.Update(b, 0))
+	assert.Equal(t, []string{"a", "c"}, queue.Values())
+
+	other := New[int, string](utils.NumbersComparator[int])
+	assert.False(t, other.Remove(c))
+	assert.True(t, c.Queued())
+
+	handle, _ := queue.PopMax()
+	assert.Equal(t, c, handle)
+	assert.False(t, queue.Remove(c))
+	assert.True(t, queue.Remove(a))
+	assert.True(t, queue.Empty())
+}
+
+func TestPriorityQueueClear(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	a := queue.Push(1, "a")
+	b := queue.Push(2, "b")
+
+	queue.Clear()
+	assert.True(t, queue.Empty())
+	assert.False(t, a.Queued())
+	assert.False(t, b.Queued())
+	assert.False(t, queue.Remove(a))
+	assert.False(t, queue.Update(b, 0))
+	assert.Equal(t, "PriorityQueue\n", queue.String())
+}
+
+func TestPriorityQueueIterator(t *testing.T) {
+	queue := New[int, string](utils.NumbersComparator[int])
+	queue.Push(2, "b1")
+	queue.Push(1, "a")
+	queue.Push(2, "b2")
+
+	var values []string
+	var priorities []int
+	it := queue.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+		priorities = append(priorities, it.Priority())
+		assert.Equal(t, it.Value(), it.Handle().Value())
+		assert.Equal(t, it.Priority(), it.Handle().Priority())
+	}
+	assert.Equal(t, []string{"a", "b1", "b2"}, values)
+	assert.Equal(t, []int{1, 2, 2}, priorities)
+
+	values = nil
+	for it.Prev() {
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []string{"b2", "b1", "a"}, values)
+
+	assert.True(t, it.Last())
+	assert.Equal(t, "b2", it.Value())
+	assert.True(t, it.First())
+	assert.Equal(t, "a", it.Value())
+	it.End()
+	assert.True(t, it.Prev())
+	assert.Equal(t, "b2", it.Value())
+	it.Begin()
+	assert.True(t, it.Next())
+	assert.Equal(t, "a", it.Value())
+
+	// iterating does not dequeue anything
+	assert.Equal(t, 3, queue.Size())
+}
+
+func TestPriorityQueueIteratorEmpty(t *testing.T) {
+	it := New[int, string](utils.NumbersComparator[int]).Iterator()
+
+	assert.False(t, it.Next())
+	assert.False(t, it.Prev())
+	assert.False(t, it.First())
+	assert.False(t, it.Last())
+}
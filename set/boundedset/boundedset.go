@@ -0,0 +1,195 @@
+// Package boundedset provides a tree set that never exceeds a capacity,
+// evicting its smallest or largest items to make room, e.g. to keep the K best items of a stream.
+package boundedset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/map/boundedmap"
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Set is a tree set whose total weight never exceeds its capacity.
+// Without a weight function every item weighs 1, so the capacity is the maximum number of items.
+type Set[V any] struct {
+	m *boundedmap.Map[V, struct{}]
+}
+
+// New instantiates a set holding up to capacity items, evicting according to the policy.
+// With EvictMin the set keeps the capacity largest items added to it.
+func New[V any](comparator utils.Comparator[V], capacity int, policy boundedmap.Policy) *Set[V] {
+	return &Set[V]{m: boundedmap.New[V, struct{}](comparator, capacity, policy)}
+}
+
+// NewWithWeight instantiates a set whose items weigh up to capacity in total, evicting according to the policy.
+// Weights must not be negative.
+func NewWithWeight[V any](comparator utils.Comparator[V], capacity int, policy boundedmap.Policy, weight func(item V) int) *Set[V] {
+	return &Set[V]{m: boundedmap.NewWithWeight[V, struct{}](comparator, capacity, policy, func(item V, _ struct{}) int {
+		return weight(item)
+	})}
+}
+
+// OnEvict registers f to be called with every item evicted to make room for another one.
+// It is not called for items removed explicitly.
+func (set *Set[V]) OnEvict(f func(item V)) {
+	set.m.OnEvict(func(item V, _ struct{}) {
+		f(item)
+	})
+}
+
+// Add adds the item to the set, evicting items from the end given by the policy while it is over capacity.
+// If the new item would be evicted itself, the set is left unchanged and false is returned.
+func (set *Set[V]) Add(item V) bool {
+	return set.m.Put(item, struct{}{})
+}
+
+// Remove removes the items (one or more) from the set.
+func (set *Set[V]) Remove(items ...V) {
+	for _, item := range items {
+		set.m.Remove(item)
+	}
+}
+
+// Contains checks whether the item is present in the set.
+func (set *Set[V]) Contains(item V) bool {
+	_, found := set.m.Get(item)
+	return found
+}
+
+// Capacity returns the maximum total weight of the set.
+func (set *Set[V]) Capacity() int {
+	return set.m.Capacity()
+}
+
+// Weight returns the total weight of the items of the set.
+func (set *Set[V]) Weight() int {
+	return set.m.Weight()
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[V]) Empty() bool {
+	return set.m.Empty()
+}
+
+// Size returns number of elements within the set.
+func (set *Set[V]) Size() int {
+	return set.m.Size()
+}
+
+// Values returns all items in the set in-order.
+func (set *Set[V]) Values() []V {
+	return set.m.Keys()
+}
+
+// First returns the minimum item of the set.
+// Returns zero value if set is empty, use FirstOk to tell that apart from a zero item.
+func (set *Set[V]) First() V {
+	item, _ := set.FirstOk()
+	return item
+}
+
+// FirstOk returns the minimum item of the set.
+// Second return parameter is true if set was not empty, otherwise false.
+func (set *Set[V]) FirstOk() (item V, found bool) {
+	entry, found := set.m.MinEntry()
+	return entry.Key, found
+}
+
+// Last returns the maximum item of the set.
+// Returns zero value if set is empty, use LastOk to tell that apart from a zero item.
+func (set *Set[V]) Last() V {
+	item, _ := set.LastOk()
+	return item
+}
+
+// LastOk returns the maximum item of the set.
+// Second return parameter is true if set was not empty, otherwise false.
+func (set *Set[V]) LastOk() (item V, found bool) {
+	entry, found := set.m.MaxEntry()
+	return entry.Key, found
+}
+
+// Each calls the given function once for each item in-order, passing that item's index and value.
+func (set *Set[V]) Each(f func(index int, value V)) {
+	index := 0
+	set.m.Each(func(item V, _ struct{}) {
+		f(index, item)
+		index++
+	})
+}
+
+// Clear removes all elements from the set without calling the eviction callback.
+func (set *Set[V]) Clear() {
+	set.m.Clear()
+}
+
+// String returns a string representation of container
+func (set *Set[V]) String() string {
+	str := "BoundedSet\n"
+	items := []string{}
+	set.Each(func(_ int, item V) {
+		items = append(items, fmt.Sprintf("%v", item))
+	})
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// Iterator holding the iterator's state
+type Iterator[V any] struct {
+	iterator treemap.Iterator[V, struct{}]
+}
+
+// Iterator returns a stateful iterator over the items of the set in-order.
+// The set must not be modified through the iterator.
+func (set *Set[V]) Iterator() Iterator[V] {
+	return Iterator[V]{iterator: set.m.Iterator()}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's value can be retrieved by Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[V]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's value can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[V]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[V]) Value() V {
+	return iterator.iterator.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[V]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[V]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's value can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[V]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's value can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[V]) Last() bool {
+	return iterator.iterator.Last()
+}
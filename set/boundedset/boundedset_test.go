@@ -0,0 +1,155 @@
+package boundedset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/map/boundedmap"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundedSetTopK(t *testing.T) {
+	set := New[int](utils.NumbersComparator[int], 3, boundedmap.EvictMin)
+	var evicted []int
+	set.OnEvict(func(item int) {
+		evicted = append(evicted, item)
+	})
+	for _, item := range []int{4, 9, 1, 7, 3, 8, 9} {
+		set.Add(item)
+	}
+	assert.Equal(t, []int{7, 8, 9}, set.Values())
+	assert.Equal(t, []int{1, 4}, evicted)
+	assert.Equal(t, 7, set.First())
+	assert.Equal(t, 9, set.Last())
+	assert.True(t, set.Contains(8))
+	assert.False(t, set.Contains(4))
+
+	var reversed []int
+	it := set.Iterator()
+	for ok := it.Last(); ok; ok = it.Prev() {
+		reversed = append(reversed, it.Value())
+	}
+	assert.Equal(t, []int{9, 8, 7}, reversed)
+	assert.Equal(t, "BoundedSet\n7, 8, 9", set.String())
+
+	set.Clear()
+	_, found := set.FirstOk()
+	assert.False(t, found)
+}
+
+func TestBoundedSetEvictMin(t *testing.T) {
+	set := New[int](utils.NumbersComparator[int], 3, boundedmap.EvictMin)
+	var evicted []int
+	set.OnEvict(func(item int) {
+		evicted = append(evicted, item)
+	})
+	for _, item := range []int{5, 3, 8} {
+		assert.True(t, set.Add(item))
+	}
+	assert.True(t, set.Add(6))
+	assert.Equal(t, []int{5, 6, 8}, set.Values())
+	assert.Equal(t, []int{3}, evicted)
+
+	// the smallest item would be evicted right away
+	assert.False(t, set.Add(1))
+	assert.Equal(t, []int{5, 6, 8}, set.Values())
+	assert.Equal(t, []int{3}, evicted)
+
+	set.Remove(8)
+	assert.True(t, set.Add(1))
+	assert.Equal(t, []int{1, 5, 6}, set.Values())
+	assert.Equal(t, 3, set.Weight())
+}
+
+func TestBoundedSetAddPresentAtCapacity(t *testing.T) {
+	set := New[int](utils.NumbersComparator[int], 2, boundedmap.EvictMin)
+	var evicted []int
+	set.OnEvict(func(item int) {
+		evicted = append(evicted, item)
+	})
+	set.Add(1)
+	set.Add(2)
+
+	// adding a present item never evicts, not even the smallest one
+	assert.True(t, set.Add(1))
+	assert.True(t, set.Add(2))
+	assert.Equal(t, []int{1, 2}, set.Values())
+	assert.Empty(t, evicted)
+	assert.Equal(t, 2, set.Weight())
+}
+
+func TestBoundedSetEvictMax(t *testing.T) {
+	set := New[int](utils.NumbersComparator[int], 2, boundedmap.EvictMax)
+	var evicted []int
+	set.OnEvict(func(item int) {
+		evicted = append(evicted, item)
+	})
+	assert.True(t, set.Add(5))
+	assert.True(t, set.Add(3))
+	assert.False(t, set.Add(9))
+	assert.True(t, set.Add(1))
+	assert.Equal(t, []int{1, 3}, set.Values())
+	assert.Equal(t, []int{5}, evicted)
+}
+
+func TestBoundedSetCapacityZero(t *testing.T) {
+	set := New[int](utils.NumbersComparator[int], 0, boundedmap.EvictMin)
+	set.OnEvict(func(item int) {
+		t.Fatalf("item %d was evicted from an empty set", item)
+	})
+
+	assert.False(t, set.Add(1))
+	assert.True(t, set.Empty())
+	assert.Equal(t, 0, set.Capacity())
+	assert.Equal(t, 0, set.Weight())
+}
+
+func TestBoundedSetCapacityOne(t *testing.T) {
+	set := New[int](utils.NumbersComparator[int], 1, boundedmap.EvictMin)
+	var evicted []int
+	set.OnEvict(func(item int) {
+		evicted = append(evicted, item)
+	})
+
+	assert.True(t, set.Add(5))
+	assert.False(t, set.Add(3))
+	assert.True(t, set.Add(7))
+	assert.True(t, set.Add(7))
+	assert.Equal(t, []int{7}, set.Values())
+	assert.Equal(t, []int{5}, evicted)
+	assert.Equal(t, 7, set.First())
+	assert.Equal(t, 7, set.Last())
+}
+
+func TestBoundedSetWeight(t *testing.T) {
+	set := NewWithWeight[string](utils.StringComparator, 6, boundedmap.EvictMax, func(item string) int {
+		return len(item)
+	})
+	assert.True(t, set.Add("bb"))
+	assert.True(t, set.Add("cccc"))
+	assert.True(t, set.Add("aaa"))
+	assert.Equal(t, []string{"aaa", "bb"}, set.Values())
+	assert.False(t, set.Add("zz"))
+	assert.Equal(t, 5, set.Weight())
+}
+
+func TestBoundedSetWeightEvictsSeveral(t *testing.T) {
+	set := NewWithWeight[string](utils.StringComparator, 7, boundedmap.EvictMin, func(item string) int {
+		return len(item)
+	})
+	var evicted []string
+	set.OnEvict(func(item string) {
+		evicted = append(evicted, item)
+	})
+	set.Add("a")
+	set.Add("bb")
+	set.Add("ccc")
+
+	// "dddd" needs 3 more units, so both "a" and "bb" go
+	assert.True(t, set.Add("dddd"))
+	assert.Equal(t, []string{"ccc", "dddd"}, set.Values())
+	assert.Equal(t, []string{"a", "bb"}, evicted)
+	// too heavy items never fit
+	assert.False(t, set.Add("zzzzzzzz"))
+	assert.Equal(t, 7, set.Weight())
+}
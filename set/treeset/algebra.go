@@ -0,0 +1,193 @@
+package treeset
+
+import (
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Union returns a new set containing the items present in either set.
+// Runs in O(m+n). Panics if the sets use different comparators.
+func (set *Set[V]) Union(other *Set[V]) *Set[V] {
+	var items []V
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		items = append(items, item)
+		return true
+	})
+	return set.fromSorted(items)
+}
+
+// Intersection returns a new set containing the items present in both sets.
+// Runs in O(m+n). Panics if the sets use different comparators.
+func (set *Set[V]) Intersection(other *Set[V]) *Set[V] {
+	var items []V
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		if inSet && inOther {
+			items = append(items, item)
+		}
+		return true
+	})
+	return set.fromSorted(items)
+}
+
+// Difference returns a new set containing the items of this set that are not present in the other set.
+// Runs in O(m+n). Panics if the sets use different comparators.
+func (set *Set[V]) Difference(other *Set[V]) *Set[V] {
+	var items []V
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		if inSet && !inOther {
+			items = append(items, item)
+		}
+		return true
+	})
+	return set.fromSorted(items)
+}
+
+// SymmetricDifference returns a new set containing the items present in exactly one of the sets.
+// Runs in O(m+n). Panics if the sets use different comparators.
+func (set *Set[V]) SymmetricDifference(other *Set[V]) *Set[V] {
+	var items []V
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		if inSet != inOther {
+			items = append(items, item)
+		}
+		return true
+	})
+	return set.fromSorted(items)
+}
+
+// UnionWith adds all items of the other set to this set.
+// Runs in O(m+n+k*log(m+k)) for k missing items. Panics if the sets use different comparators.
+func (set *Set[V]) UnionWith(other *Set[V]) {
+	var missing []V
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		if !inSet {
+			missing = append(missing, item)
+		}
+		return true
+	})
+	set.Add(missing...)
+}
+
+// IntersectWith removes all items of this set that are not present in the other set.
+// Runs in O(m+n+k*log(m)) for k absent items. Panics if the sets use different comparators.
+func (set *Set[V]) IntersectWith(other *Set[V]) {
+	var absent []V
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		if inSet && !inOther {
+			absent = append(absent, item)
+		}
+		return true
+	})
+	set.Remove(absent...)
+}
+
+// IsSubsetOf returns true if all items of this set are present in the other set.
+// Panics if the sets use different comparators.
+func (set *Set[V]) IsSubsetOf(other *Set[V]) bool {
+	if set.Size() > other.Size() {
+		set.checkComparator(other)
+		return false
+	}
+	subset := true
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		subset = !inSet || inOther
+		return subset
+	})
+	return subset
+}
+
+// IsSupersetOf returns true if all items of the other set are present in this set.
+// Panics if the sets use different comparators.
+func (set *Set[V]) IsSupersetOf(other *Set[V]) bool {
+	return other.IsSubsetOf(set)
+}
+
+// IsDisjoint returns true if the sets have no items in common.
+// Panics if the sets use different comparators.
+func (set *Set[V]) IsDisjoint(other *Set[V]) bool {
+	disjoint := true
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		disjoint = !inSet || !inOther
+		return disjoint
+	})
+	return disjoint
+}
+
+// Equal returns true if both sets contain the same items.
+// Panics if the sets use different comparators.
+func (set *Set[V]) Equal(other *Set[V]) bool {
+	if set.Size() != other.Size() {
+		set.checkComparator(other)
+		return false
+	}
+	equal := true
+	set.merge(other, func(item V, inSet, inOther bool) bool {
+		equal = inSet && inOther
+		return equal
+	})
+	return equal
+}
+
+// merge walks both sets in-order at once, calling f for every item with the sets it is present in,
+// until f returns false.
+// Comparators built by the same function literal cannot be told apart up front (see utils.SameComparator),
+// so merge also compares every pair of items by both comparators and panics when they disagree.
+func (set *Set[V]) merge(other *Set[V], f func(item V, inSet, inOther bool) bool) {
+	set.checkComparator(other)
+	comparator, otherComparator := set.tree.Comparator, other.tree.Comparator
+	a, b := set.tree.Iterator(), other.tree.Iterator()
+	okA, okB := a.Next(), b.Next()
+	for okA || okB {
+		var compare int
+		switch {
+		case !okB:
+			compare = -1
+		case !okA:
+			compare = 1
+		default:
+			compare = comparator(a.Key(), b.Key())
+			if sign(compare) != sign(otherComparator(a.Key(), b.Key())) {
+				panic("treeset: sets use different comparators")
+			}
+		}
+
+		var proceed bool
+		switch {
+		case compare < 0:
+			proceed = f(a.Key(), true, false)
+			okA = a.Next()
+		case compare > 0:
+			proceed = f(b.Key(), false, true)
+			okB = b.Next()
+		default:
+			proceed = f(a.Key(), true, true)
+			okA, okB = a.Next(), b.Next()
+		}
+		if !proceed {
+			return
+		}
+	}
+}
+
+func sign(compare int) int {
+	switch {
+	case compare < 0:
+		return -1
+	case compare > 0:
+		return 1
+	}
+	return 0
+}
+
+func (set *Set[V]) checkComparator(other *Set[V]) {
+	if !utils.SameComparator(set.tree.Comparator, other.tree.Comparator) {
+		panic("treeset: sets use different comparators")
+	}
+}
+
+// fromSorted returns a new set with the same comparator holding the given in-order items.
+func (set *Set[V]) fromSorted(items []V) *Set[V] {
+	newSet := &Set[V]{tree: redblacktree.NewWithComparator[V, struct{}](set.tree.Comparator)}
+	newSet.tree.Load(items, make([]struct{}, len(items)))
+	return newSet
+}
@@ -0,0 +1,194 @@
+package treeset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetUnion(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 3, 5)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, a.Union(b).Values())
+	assert.Equal(t, []int{1, 3, 5}, a.Union(NewWithComparator[int](utils.NumbersComparator[int])).Values())
+	assert.Equal(t, []int{1, 3, 5}, a.Values())
+	assert.Equal(t, []int{2, 3, 4}, b.Values())
+}
+
+func TestSetIntersection(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2, 3, 5)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+
+	assert.Equal(t, []int{2, 3}, a.Intersection(b).Values())
+	assert.Empty(t, a.Intersection(NewWithComparator[int](utils.NumbersComparator[int])).Values())
+}
+
+func TestSetDifference(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2, 3, 5)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+
+	assert.Equal(t, []int{1, 5}, a.Difference(b).Values())
+	assert.Equal(t, []int{4}, b.Difference(a).Values())
+	assert.Empty(t, a.Difference(a).Values())
+}
+
+func TestSetSymmetricDifference(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2, 3, 5)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+
+	assert.Equal(t, []int{1, 4, 5}, a.SymmetricDifference(b).Values())
+	assert.Equal(t, []int{1, 4, 5}, b.SymmetricDifference(a).Values())
+}
+
+func TestSetUnionWith(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 3)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+
+	a.UnionWith(b)
+	assert.Equal(t, []int{1, 2, 3, 4}, a.Values())
+	assert.Equal(t, 4, a.Size())
+	assert.Equal(t, []int{2, 3, 4}, b.Values())
+}
+
+func TestSetIntersectWith(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2, 3)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+
+	a.IntersectWith(b)
+	assert.Equal(t, []int{2, 3}, a.Values())
+	assert.Equal(t, 2, a.Size())
+
+	a.IntersectWith(NewWithComparator[int](utils.NumbersComparator[int]))
+	assert.True(t, a.Empty())
+}
+
+func TestSetIsSubsetOf(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(2, 3)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(1, 2, 3)
+	empty := NewWithComparator[int](utils.NumbersComparator[int])
+
+	assert.True(t, a.IsSubsetOf(b))
+	assert.False(t, b.IsSubsetOf(a))
+	assert.True(t, a.IsSubsetOf(a))
+	assert.True(t, empty.IsSubsetOf(a))
+	assert.True(t, b.IsSupersetOf(a))
+	assert.False(t, a.IsSupersetOf(b))
+
+	a.Add(4)
+	assert.False(t, a.IsSubsetOf(b))
+}
+
+func TestSetIsDisjoint(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 3)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 4)
+
+	assert.True(t, a.IsDisjoint(b))
+	assert.True(t, a.IsDisjoint(NewWithComparator[int](utils.NumbersComparator[int])))
+
+	b.Add(3)
+	assert.False(t, a.IsDisjoint(b))
+}
+
+func TestSetEqual(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2, 3)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(3, 2, 1)
+
+	assert.True(t, a.Equal(b))
+	assert.True(t, NewWithComparator[int](utils.NumbersComparator[int]).Equal(NewWithComparator[int](utils.NumbersComparator[int])))
+
+	b.Remove(3)
+	b.Add(4)
+	assert.False(t, a.Equal(b))
+	b.Remove(4)
+	assert.False(t, a.Equal(b))
+}
+
+func TestSetAlgebraDifferentComparators(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2)
+	b := NewWithComparator[int](func(x, y int) int { return utils.NumbersComparator(y, x) })
+	b.Add(1)
+
+	message := "treeset: sets use different comparators"
+	assert.PanicsWithValue(t, message, func() { a.Union(b) })
+	assert.PanicsWithValue(t, message, func() { a.Intersection(b) })
+	assert.PanicsWithValue(t, message, func() { a.Difference(b) })
+	assert.PanicsWithValue(t, message, func() { a.SymmetricDifference(b) })
+	assert.PanicsWithValue(t, message, func() { a.UnionWith(b) })
+	assert.PanicsWithValue(t, message, func() { a.IntersectWith(b) })
+	assert.PanicsWithValue(t, message, func() { a.IsSubsetOf(b) })
+	assert.PanicsWithValue(t, message, func() { b.IsSubsetOf(a) })
+	assert.PanicsWithValue(t, message, func() { a.IsDisjoint(b) })
+	assert.PanicsWithValue(t, message, func() { a.Equal(b) })
+}
+
+func TestSetAlgebraDisagreeingClosures(t *testing.T) {
+	newSet := func(items ...int) *Set[int] {
+		set := NewWithComparator[int](utils.NumbersComparator[int])
+		set.Add(items...)
+		return set
+	}
+	// both comparators come from the same function literal, so only their orders tell them apart
+	ascending := NewWithComparator[*Set[int]](Comparator(utils.NumbersComparator[int]))
+	ascending.Add(newSet(1), newSet(2))
+	descending := NewWithComparator[*Set[int]](Comparator(func(a, b int) int { return utils.NumbersComparator(b, a) }))
+	descending.Add(newSet(1), newSet(2))
+
+	message := "treeset: sets use different comparators"
+	assert.PanicsWithValue(t, message, func() { ascending.Union(descending) })
+	assert.PanicsWithValue(t, message, func() { ascending.Intersection(descending) })
+	assert.PanicsWithValue(t, message, func() { ascending.Difference(descending) })
+	assert.PanicsWithValue(t, message, func() { ascending.IsSubsetOf(descending) })
+	assert.PanicsWithValue(t, message, func() { descending.Union(ascending) })
+}
+
+func TestSetUnionWithReportsAddedItems(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 3)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+	var events []redblacktree.Event[int, struct{}]
+	a.Observe(func(event redblacktree.Event[int, struct{}]) { events = append(events, event) })
+
+	a.UnionWith(b)
+	assert.Equal(t, []redblacktree.Event[int, struct{}]{
+		{Kind: redblacktree.Inserted, Key: 2},
+		{Kind: redblacktree.Inserted, Key: 4},
+	}, events)
+}
+
+func TestSetIntersectWithReportsRemovedItems(t *testing.T) {
+	a := NewWithComparator[int](utils.NumbersComparator[int])
+	a.Add(1, 2, 3, 5)
+	b := NewWithComparator[int](utils.NumbersComparator[int])
+	b.Add(2, 3, 4)
+	var events []redblacktree.Event[int, struct{}]
+	a.Observe(func(event redblacktree.Event[int, struct{}]) { events = append(events, event) })
+
+	a.IntersectWith(b)
+	assert.Equal(t, []redblacktree.Event[int, struct{}]{
+		{Kind: redblacktree.Removed, Key: 1},
+		{Kind: redblacktree.Removed, Key: 5},
+	}, events)
+}
@@ -0,0 +1,35 @@
+package treeset
+
+import "github.com/mikekonan/gods-generic/utils"
+
+// Hash returns a hash of the set combining itemHash of every item in-order,
+// so sets that are Equal hash the same as long as the hasher agrees with the comparator.
+func (set *Set[V]) Hash(itemHash func(V) uint64) uint64 {
+	hash := utils.HashSeed
+	for it := set.tree.Iterator(); it.Next(); {
+		hash = utils.HashCombine(hash, itemHash(it.Key()))
+	}
+	return hash
+}
+
+// Comparator returns a comparator ordering sets lexicographically by their items in-order,
+// comparing items with itemComparator. A set that is a prefix of another comes first.
+func Comparator[V any](itemComparator utils.Comparator[V]) utils.Comparator[*Set[V]] {
+	return func(a, b *Set[V]) int {
+		itA, itB := a.tree.Iterator(), b.tree.Iterator()
+		for {
+			okA, okB := itA.Next(), itB.Next()
+			switch {
+			case !okA && !okB:
+				return 0
+			case !okA:
+				return -1
+			case !okB:
+				return 1
+			}
+			if compare := itemComparator(itA.Key(), itB.Key()); compare != 0 {
+				return compare
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package treeset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetHash(t *testing.T) {
+	a := NewWithComparator[string](utils.StringComparator)
+	a.Add("a", "b", "c")
+	b := NewWithComparator[string](utils.StringComparator)
+	b.Add("c", "a", "b")
+
+	assert.Equal(t, a.Hash(utils.StringHash), b.Hash(utils.StringHash))
+	b.Remove("c")
+	assert.NotEqual(t, a.Hash(utils.StringHash), b.Hash(utils.StringHash))
+	assert.NotEqual(t, b.Hash(utils.StringHash), NewWithComparator[string](utils.StringComparator).Hash(utils.StringHash))
+}
+
+func TestSetComparator(t *testing.T) {
+	comparator := Comparator(utils.NumbersComparator[int])
+	newSet := func(items ...int) *Set[int] {
+		set := NewWithComparator[int](utils.NumbersComparator[int])
+		set.Add(items...)
+		return set
+	}
+
+	tests := []struct {
+		a, b     *Set[int]
+		expected int
+	}{
+		{newSet(), newSet(), 0},
+		{newSet(), newSet(1), -1},
+		{newSet(1, 2), newSet(2, 1), 0},
+		{newSet(1), newSet(1, 2), -1},
+		{newSet(1, 3), newSet(1, 2, 4), 1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, comparator(test.a, test.b))
+		assert.Equal(t, -test.expected, comparator(test.b, test.a))
+	}
+}
+
+func TestSetOfSets(t *testing.T) {
+	newSet := func(items ...int) *Set[int] {
+		set := NewWithComparator[int](utils.NumbersComparator[int])
+		set.Add(items...)
+		return set
+	}
+
+	sets := NewWithComparator[*Set[int]](Comparator(utils.NumbersComparator[int]))
+	sets.Add(newSet(2), newSet(1, 2), newSet(), newSet(1), newSet(2, 1))
+
+	var values [][]int
+	for _, set := range sets.Values() {
+		values = append(values, set.Values())
+	}
+	assert.Equal(t, [][]int{{}, {1}, {1, 2}, {2}}, values)
+	assert.True(t, sets.Contains(newSet(1, 2)))
+	assert.False(t, sets.Contains(newSet(3)))
+}
@@ -0,0 +1,65 @@
+package treeset
+
+import "fmt"
+
+// Patch holds the differences between two versions of a set, every list in-order.
+// It can be serialized as long as its items can.
+type Patch[V any] struct {
+	Added   []V `json:"added,omitempty"`
+	Removed []V `json:"removed,omitempty"`
+}
+
+// Empty returns true if the patch holds no differences.
+func (patch Patch[V]) Empty() bool {
+	return len(patch.Added) == 0 && len(patch.Removed) == 0
+}
+
+// ConflictError is returned by ApplyStrict when the set does not match the base of the patch.
+type ConflictError[V any] struct {
+	Item   V
+	Reason string
+}
+
+// Error returns the error message with the conflicting item.
+func (err *ConflictError[V]) Error() string {
+	return fmt.Sprintf("treeset: patch conflicts at item %v: %s", err.Item, err.Reason)
+}
+
+// Diff returns the patch turning the old set into the new one.
+// Runs in O(m+n). Panics if the sets use different comparators.
+func Diff[V any](old, new *Set[V]) Patch[V] {
+	var patch Patch[V]
+	old.merge(new, func(item V, inOld, inNew bool) bool {
+		switch {
+		case !inNew:
+			patch.Removed = append(patch.Removed, item)
+		case !inOld:
+			patch.Added = append(patch.Added, item)
+		}
+		return true
+	})
+	return patch
+}
+
+// Apply applies the patch to the set, regardless of its current items.
+func (set *Set[V]) Apply(patch Patch[V]) {
+	set.Remove(patch.Removed...)
+	set.Add(patch.Added...)
+}
+
+// ApplyStrict applies the patch to the set only if the set matches the base the patch was made from.
+// Otherwise it returns a *ConflictError and leaves the set unchanged.
+func (set *Set[V]) ApplyStrict(patch Patch[V]) error {
+	for _, item := range patch.Removed {
+		if !set.Contains(item) {
+			return &ConflictError[V]{Item: item, Reason: "removed item is missing"}
+		}
+	}
+	for _, item := range patch.Added {
+		if set.Contains(item) {
+			return &ConflictError[V]{Item: item, Reason: "added item is present"}
+		}
+	}
+	set.Apply(patch)
+	return nil
+}
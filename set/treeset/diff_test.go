@@ -0,0 +1,100 @@
+package treeset
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDiff(t *testing.T) {
+	old := NewWithComparator[int](utils.NumbersComparator[int])
+	old.Add(1, 2, 3)
+	new := NewWithComparator[int](utils.NumbersComparator[int])
+	new.Add(2, 3, 4, 5)
+
+	patch := Diff(old, new)
+	assert.Equal(t, []int{4, 5}, patch.Added)
+	assert.Equal(t, []int{1}, patch.Removed)
+	assert.False(t, patch.Empty())
+	assert.True(t, Diff(old, old).Empty())
+}
+
+func TestSetApply(t *testing.T) {
+	old := NewWithComparator[int](utils.NumbersComparator[int])
+	old.Add(1, 2)
+	new := NewWithComparator[int](utils.NumbersComparator[int])
+	new.Add(2, 3)
+	patch := Diff(old, new)
+
+	drifted := NewWithComparator[int](utils.NumbersComparator[int])
+	drifted.Add(3, 7)
+	drifted.Apply(patch)
+	assert.Equal(t, []int{3, 7}, drifted.Values())
+
+	old.Apply(patch)
+	assert.Equal(t, []int{2, 3}, old.Values())
+}
+
+func TestSetApplyStrict(t *testing.T) {
+	old := NewWithComparator[int](utils.NumbersComparator[int])
+	old.Add(1, 2)
+	new := NewWithComparator[int](utils.NumbersComparator[int])
+	new.Add(2, 3)
+	patch := Diff(old, new)
+
+	assert.NoError(t, old.ApplyStrict(patch))
+	assert.Equal(t, []int{2, 3}, old.Values())
+}
+
+func TestSetApplyStrictConflicts(t *testing.T) {
+	old := NewWithComparator[int](utils.NumbersComparator[int])
+	old.Add(1, 2)
+	new := NewWithComparator[int](utils.NumbersComparator[int])
+	new.Add(2, 3)
+	patch := Diff(old, new)
+
+	tests := []struct {
+		drift  func(set *Set[int])
+		item   int
+		reason string
+	}{
+		{func(set *Set[int]) { set.Remove(1) }, 1, "removed item is missing"},
+		{func(set *Set[int]) { set.Add(3) }, 3, "added item is present"},
+	}
+
+	for _, test := range tests {
+		drifted := NewWithComparator[int](utils.NumbersComparator[int])
+		drifted.Add(1, 2)
+		test.drift(drifted)
+		items := drifted.Values()
+
+		err := drifted.ApplyStrict(patch)
+		var conflict *ConflictError[int]
+		assert.True(t, errors.As(err, &conflict))
+		assert.Equal(t, test.item, conflict.Item)
+		assert.Equal(t, test.reason, conflict.Reason)
+		assert.Equal(t, items, drifted.Values())
+	}
+}
+
+func TestSetPatchJSON(t *testing.T) {
+	old := NewWithComparator[string](utils.StringComparator)
+	old.Add("a", "b")
+	new := NewWithComparator[string](utils.StringComparator)
+	new.Add("b", "c")
+	patch := Diff(old, new)
+
+	data, err := json.Marshal(patch)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"added": ["c"], "removed": ["a"]}`, string(data))
+
+	var decoded Patch[string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, patch, decoded)
+
+	assert.NoError(t, old.ApplyStrict(decoded))
+	assert.Equal(t, []string{"b", "c"}, old.Values())
+}
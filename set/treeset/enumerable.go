@@ -4,7 +4,12 @@
 
 package treeset
 
-import "github.com/mikekonan/gods-generic/tree/redblacktree"
+import (
+	"fmt"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
 
 // Each calls the given function once for each element, passing that element's index and value.
 func (set *Set[V]) Each(f func(index int, value V)) {
@@ -25,6 +30,51 @@ func (set *Set[V]) Map(f func(index int, value V) V) *Set[V] {
 	return newSet
 }
 
+// MapMerge works like Map, but when the given function produces equal values more than once,
+// the value stored in the set is merge(existing, incoming) instead of the incoming value.
+// The merged value must be equal to both according to the comparator.
+func (set *Set[V]) MapMerge(f func(index int, value V) V, merge func(existing, incoming V) V) *Set[V] {
+	// the tree keeps the first key of equal ones, so the merged items are kept as values and loaded afterwards
+	merged := redblacktree.NewWithComparator[V, V](set.tree.Comparator)
+	iterator := set.Iterator()
+	for iterator.Next() {
+		value := f(iterator.Index(), iterator.Value())
+		merged.Merge(value, value, merge)
+	}
+	return set.fromSorted(merged.Values())
+}
+
+// MapWithPolicy works like Map, but resolves values produced more than once by the given function according to the policy.
+// Under utils.FailOnCollision it returns a *utils.CollisionError listing all colliding values instead of the container.
+// Panics if the policy is unknown.
+func (set *Set[V]) MapWithPolicy(f func(index int, value V) V, policy utils.CollisionPolicy) (*Set[V], error) {
+	switch policy {
+	case utils.KeepLast, utils.KeepFirst, utils.FailOnCollision:
+	default:
+		panic(fmt.Sprintf("treeset: unknown collision policy %d", policy))
+	}
+	newSet := &Set[V]{tree: redblacktree.NewWithComparator[V, struct{}](set.tree.Comparator)}
+	collisions := redblacktree.NewWithComparator[V, struct{}](set.tree.Comparator)
+	iterator := set.Iterator()
+	for iterator.Next() {
+		value := f(iterator.Index(), iterator.Value())
+		switch policy {
+		case utils.KeepFirst:
+			newSet.tree.PutIfAbsent(value, itemExists)
+		case utils.FailOnCollision:
+			if _, loaded := newSet.tree.PutIfAbsent(value, itemExists); loaded {
+				collisions.Put(value, itemExists)
+			}
+		case utils.KeepLast:
+			newSet.tree.Put(value, itemExists)
+		}
+	}
+	if !collisions.Empty() {
+		return nil, &utils.CollisionError[V]{Keys: collisions.Keys()}
+	}
+	return newSet, nil
+}
+
 // Select returns a new container containing all elements for which the given function returns a true value.
 func (set *Set[V]) Select(f func(index int, value V) bool) *Set[V] {
 	newSet := &Set[V]{tree: redblacktree.NewWithComparator[V, struct{}](set.tree.Comparator)}
@@ -62,7 +112,7 @@ func (set *Set[V]) All(f func(index int, value V) bool) bool {
 }
 
 // Find passes each element of the container to the given function and returns
-// the first (index,value) for which the function is true or -1,nil otherwise
+// the first (index,value) for which the function is true or -1 and zero value otherwise
 // if no element matches the criteria.
 func (set *Set[V]) Find(f func(index int, value V) bool) (i int, v V) {
 	iterator := set.Iterator()
@@ -0,0 +1,85 @@
+package treeset
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type tagged struct {
+	key int
+	tag string
+}
+
+func taggedComparator(a, b tagged) int {
+	return utils.NumbersComparator(a.key, b.key)
+}
+
+func TestSetMapMerge(t *testing.T) {
+	set := NewWithComparator[tagged](taggedComparator)
+	set.Add(tagged{1, "a"}, tagged{2, "b"}, tagged{11, "c"}, tagged{12, "d"})
+
+	merged := set.MapMerge(func(index int, value tagged) tagged {
+		return tagged{value.key % 10, value.tag}
+	}, func(existing, incoming tagged) tagged {
+		return tagged{existing.key, existing.tag + incoming.tag}
+	})
+	assert.Equal(t, []tagged{{1, "ac"}, {2, "bd"}}, merged.Values())
+}
+
+func TestSetMapMergeFoldsInOrder(t *testing.T) {
+	set := NewWithComparator[tagged](taggedComparator)
+	set.Add(tagged{1, "a"}, tagged{3, "x"}, tagged{11, "b"}, tagged{21, "c"})
+
+	calls := 0
+	merged := set.MapMerge(func(index int, value tagged) tagged {
+		return tagged{value.key % 10, value.tag}
+	}, func(existing, incoming tagged) tagged {
+		calls++
+		return tagged{existing.key, existing.tag + incoming.tag}
+	})
+	assert.Equal(t, []tagged{{1, "abc"}, {3, "x"}}, merged.Values())
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 2, merged.Size())
+}
+
+func TestSetMapWithPolicy(t *testing.T) {
+	set := NewWithComparator[tagged](taggedComparator)
+	set.Add(tagged{1, "a"}, tagged{2, "b"}, tagged{11, "c"}, tagged{21, "d"})
+	modulo := func(index int, value tagged) tagged {
+		return tagged{value.key % 10, value.tag}
+	}
+
+	tests := []struct {
+		policy utils.CollisionPolicy
+		values []tagged
+	}{
+		{utils.KeepLast, []tagged{{1, "d"}, {2, "b"}}},
+		{utils.KeepFirst, []tagged{{1, "a"}, {2, "b"}}},
+	}
+	for _, test := range tests {
+		mapped, err := set.MapWithPolicy(modulo, test.policy)
+		assert.NoError(t, err)
+		assert.Equal(t, test.values, mapped.Values())
+	}
+
+	mapped, err := set.MapWithPolicy(modulo, utils.FailOnCollision)
+	assert.Nil(t, mapped)
+	var collision *utils.CollisionError[tagged]
+	assert.True(t, errors.As(err, &collision))
+	assert.Len(t, collision.Keys, 1)
+	assert.Equal(t, 1, collision.Keys[0].key)
+}
+
+func TestSetMapWithUnknownPolicy(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	identity := func(index int, value int) int {
+		return value
+	}
+
+	assert.PanicsWithValue(t, "treeset: unknown collision policy 7", func() {
+		set.MapWithPolicy(identity, utils.CollisionPolicy(7))
+	})
+}
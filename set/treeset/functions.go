@@ -0,0 +1,119 @@
+package treeset
+
+import (
+	"github.com/mikekonan/gods-generic/map/treemap"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// MapTo invokes the given function once for each element and returns a new set ordered by the given comparator
+// containing the values returned by the function, which may be of a different type than the set's.
+func MapTo[V any, V2 any](set *Set[V], comparator utils.Comparator[V2], f func(index int, value V) V2) *Set[V2] {
+	newSet := NewWithComparator(comparator)
+	iterator := set.Iterator()
+	for iterator.Next() {
+		newSet.Add(f(iterator.Index(), iterator.Value()))
+	}
+	return newSet
+}
+
+// FlatMap invokes the given function once for each element and returns a new set ordered by the given comparator
+// containing all values returned by the function.
+func FlatMap[V any, V2 any](set *Set[V], comparator utils.Comparator[V2], f func(index int, value V) []V2) *Set[V2] {
+	newSet := NewWithComparator(comparator)
+	iterator := set.Iterator()
+	for iterator.Next() {
+		newSet.Add(f(iterator.Index(), iterator.Value())...)
+	}
+	return newSet
+}
+
+// Fold passes each element in-order to the given function together with the accumulated value,
+// starting from initial, and returns the final accumulated value.
+func Fold[V any, A any](set *Set[V], initial A, f func(acc A, index int, value V) A) A {
+	acc := initial
+	iterator := set.Iterator()
+	for iterator.Next() {
+		acc = f(acc, iterator.Index(), iterator.Value())
+	}
+	return acc
+}
+
+// Reduce passes each element but the first in-order to the given function together with the accumulated value,
+// starting from the first element, and returns the final accumulated value.
+// Second return parameter is true if set is not empty, otherwise false.
+func Reduce[V any](set *Set[V], f func(acc V, index int, value V) V) (result V, found bool) {
+	iterator := set.Iterator()
+	if !iterator.Next() {
+		return
+	}
+	result = iterator.Value()
+	for iterator.Next() {
+		result = f(result, iterator.Index(), iterator.Value())
+	}
+	return result, true
+}
+
+// GroupBy splits the set into groups keyed by the result of the given function,
+// ordered by the given comparator. Every group is a set with the same comparator as the original set.
+func GroupBy[V any, G any](set *Set[V], comparator utils.Comparator[G], f func(index int, value V) G) *treemap.Map[G, *Set[V]] {
+	groups := treemap.NewWithComparator[G, *Set[V]](comparator)
+	iterator := set.Iterator()
+	for iterator.Next() {
+		group, _ := groups.GetOrPut(f(iterator.Index(), iterator.Value()), func() *Set[V] {
+			return NewWithComparator(set.tree.Comparator)
+		})
+		group.Add(iterator.Value())
+	}
+	return groups
+}
+
+// Partition splits the set into the elements for which the given function returns true and the rest.
+// Runs in O(n).
+func Partition[V any](set *Set[V], f func(index int, value V) bool) (matched *Set[V], rest *Set[V]) {
+	var matchedItems, restItems []V
+	iterator := set.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			matchedItems = append(matchedItems, iterator.Value())
+		} else {
+			restItems = append(restItems, iterator.Value())
+		}
+	}
+	return set.fromSorted(matchedItems), set.fromSorted(restItems)
+}
+
+// Count returns the number of elements for which the given function returns true.
+func Count[V any](set *Set[V], f func(index int, value V) bool) int {
+	count := 0
+	iterator := set.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// MinBy returns the first element for which the given function returns the smallest result according to the comparator.
+// Second return parameter is true if set is not empty, otherwise false.
+func MinBy[V any, T any](set *Set[V], comparator utils.Comparator[T], f func(index int, value V) T) (value V, found bool) {
+	return extremeBy(set, comparator, f, -1)
+}
+
+// MaxBy returns the first element for which the given function returns the largest result according to the comparator.
+// Second return parameter is true if set is not empty, otherwise false.
+func MaxBy[V any, T any](set *Set[V], comparator utils.Comparator[T], f func(index int, value V) T) (value V, found bool) {
+	return extremeBy(set, comparator, f, 1)
+}
+
+func extremeBy[V any, T any](set *Set[V], comparator utils.Comparator[T], f func(index int, value V) T, sign int) (value V, found bool) {
+	var best T
+	iterator := set.Iterator()
+	for iterator.Next() {
+		result := f(iterator.Index(), iterator.Value())
+		if !found || comparator(result, best)*sign > 0 {
+			value, best, found = iterator.Value(), result, true
+		}
+	}
+	return value, found
+}
@@ -0,0 +1,154 @@
+package treeset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFunctionsMapTo(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("a", "bb", "ccc", "dd")
+
+	lengths := MapTo(set, utils.NumbersComparator[int], func(index int, value string) int {
+		return len(value)
+	})
+	assert.Equal(t, []int{1, 2, 3}, lengths.Values())
+}
+
+func TestSetFunctionsFlatMap(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("ab", "", "ca")
+
+	letters := FlatMap(set, utils.StringComparator, func(index int, value string) []string {
+		return strings.Split(value, "")
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, letters.Values())
+}
+
+func TestSetFunctionsFold(t *testing.T) {
+	tests := []struct {
+		items    []string
+		expected string
+	}{
+		{nil, ">"},
+		{[]string{"a"}, ">0a"},
+		{[]string{"c", "a", "b"}, ">0a1b2c"},
+	}
+
+	for _, test := range tests {
+		set := NewWithComparator[string](utils.StringComparator)
+		set.Add(test.items...)
+		actual := Fold(set, ">", func(acc string, index int, value string) string {
+			return acc + string(rune('0'+index)) + value
+		})
+		assert.Equal(t, test.expected, actual)
+	}
+}
+
+func TestSetFunctionsReduce(t *testing.T) {
+	tests := []struct {
+		items    []int
+		expected int
+		found    bool
+	}{
+		{nil, 0, false},
+		{[]int{5}, 5, true},
+		{[]int{3, 1, 2}, 6, true},
+	}
+
+	for _, test := range tests {
+		set := NewWithComparator[int](utils.NumbersComparator[int])
+		set.Add(test.items...)
+		actual, found := Reduce(set, func(acc int, index int, value int) int {
+			return acc + value
+		})
+		assert.Equal(t, test.expected, actual)
+		assert.Equal(t, test.found, found)
+	}
+}
+
+func TestSetFunctionsGroupBy(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("a", "bb", "c", "dd", "eee")
+
+	groups := GroupBy(set, utils.NumbersComparator[int], func(index int, value string) int {
+		return len(value)
+	})
+	assert.Equal(t, []int{1, 2, 3}, groups.Keys())
+	short, _ := groups.Get(1)
+	assert.Equal(t, []string{"a", "c"}, short.Values())
+	long, _ := groups.Get(3)
+	assert.Equal(t, []string{"eee"}, long.Values())
+}
+
+func TestSetFunctionsPartition(t *testing.T) {
+	tests := []struct {
+		items   []int
+		matched []int
+		rest    []int
+	}{
+		{nil, []int{}, []int{}},
+		{[]int{1, 3}, []int{}, []int{1, 3}},
+		{[]int{4, 1, 2, 3}, []int{2, 4}, []int{1, 3}},
+	}
+
+	for _, test := range tests {
+		set := NewWithComparator[int](utils.NumbersComparator[int])
+		set.Add(test.items...)
+		matched, rest := Partition(set, func(index int, value int) bool {
+			return value%2 == 0
+		})
+		assert.Equal(t, test.matched, matched.Values())
+		assert.Equal(t, test.rest, rest.Values())
+		assert.Equal(t, len(test.items), set.Size())
+	}
+}
+
+func TestSetFunctionsCount(t *testing.T) {
+	tests := []struct {
+		items    []int
+		expected int
+	}{
+		{nil, 0},
+		{[]int{1, 3}, 0},
+		{[]int{1, 2, 3, 4}, 2},
+	}
+
+	for _, test := range tests {
+		set := NewWithComparator[int](utils.NumbersComparator[int])
+		set.Add(test.items...)
+		assert.Equal(t, test.expected, Count(set, func(index int, value int) bool {
+			return value%2 == 0
+		}))
+	}
+}
+
+func TestSetFunctionsMinByMaxBy(t *testing.T) {
+	tests := []struct {
+		items []string
+		min   string
+		max   string
+		found bool
+	}{
+		{nil, "", "", false},
+		{[]string{"a"}, "a", "a", true},
+		{[]string{"bb", "a", "ccc", "d", "eee"}, "a", "ccc", true},
+	}
+
+	length := func(index int, value string) int {
+		return len(value)
+	}
+	for _, test := range tests {
+		set := NewWithComparator[string](utils.StringComparator)
+		set.Add(test.items...)
+		min, found := MinBy(set, utils.NumbersComparator[int], length)
+		assert.Equal(t, test.min, min)
+		assert.Equal(t, test.found, found)
+		max, found := MaxBy(set, utils.NumbersComparator[int], length)
+		assert.Equal(t, test.max, max)
+		assert.Equal(t, test.found, found)
+	}
+}
@@ -0,0 +1,56 @@
+//go:build go1.23
+
+package treeset
+
+import (
+	"iter"
+
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Collect instantiates a new set with the custom comparator holding the items of the sequence.
+func Collect[V any](comparator utils.Comparator[V], seq iter.Seq[V]) *Set[V] {
+	set := NewWithComparator(comparator)
+	for item := range seq {
+		set.Add(item)
+	}
+	return set
+}
+
+// AllSeq returns an iterator over all (index, item) pairs in-order.
+func (set *Set[V]) AllSeq() iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		index := 0
+		for item := range set.tree.KeysSeq() {
+			if !yield(index, item) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over all items in-order.
+func (set *Set[V]) ValuesSeq() iter.Seq[V] {
+	return set.tree.KeysSeq()
+}
+
+// BackwardSeq returns an iterator over all items in-reverse-order.
+func (set *Set[V]) BackwardSeq() iter.Seq[V] {
+	return keys(set.tree.BackwardSeq())
+}
+
+// RangeSeq returns an iterator over the items ranging from lo, inclusive, to hi, exclusive, in-order.
+func (set *Set[V]) RangeSeq(lo, hi V) iter.Seq[V] {
+	return keys(set.tree.RangeSeq(lo, hi))
+}
+
+func keys[V any](seq iter.Seq2[V, struct{}]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+//go:build go1.23
+
+package treeset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAllSeq(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("c", "a", "b")
+
+	var indices []int
+	var items []string
+	for index, item := range set.AllSeq() {
+		indices = append(indices, index)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+}
+
+func TestSetAllSeqBreak(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("c", "a", "b")
+
+	var indices []int
+	for index := range set.AllSeq() {
+		indices = append(indices, index)
+		if index == 1 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1}, indices)
+
+	// every range starts counting from zero again
+	for index, item := range set.AllSeq() {
+		assert.Equal(t, 0, index)
+		assert.Equal(t, "a", item)
+		break
+	}
+}
+
+func TestSetValuesSeq(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("c", "a", "b")
+
+	var items []string
+	for item := range set.ValuesSeq() {
+		items = append(items, item)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+
+	items = items[:0]
+	for item := range set.ValuesSeq() {
+		items = append(items, item)
+		break
+	}
+	assert.Equal(t, []string{"a"}, items)
+}
+
+func TestSetBackwardSeq(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("c", "a", "b")
+
+	var items []string
+	for item := range set.BackwardSeq() {
+		items = append(items, item)
+	}
+	assert.Equal(t, []string{"c", "b", "a"}, items)
+
+	items = items[:0]
+	for item := range set.BackwardSeq() {
+		items = append(items, item)
+		if item == "b" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"c", "b"}, items)
+}
+
+func TestSetRangeSeq(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 2, 3, 4, 5)
+
+	var items []int
+	for item := range set.RangeSeq(2, 5) {
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{2, 3, 4}, items)
+	assert.Equal(t, set.SubSet(2, 5).Values(), items)
+
+	items = items[:0]
+	for item := range set.RangeSeq(2, 5) {
+		items = append(items, item)
+		if item == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 3}, items)
+
+	for range set.RangeSeq(4, 2) {
+		t.Fatal("an inverted range yielded an item")
+	}
+}
+
+func TestSetSeqSeesViewWrites(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 5)
+	view := set.SubSet(2, 5)
+
+	assert.NoError(t, view.Add(3))
+	var items []int
+	for item := range set.ValuesSeq() {
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{1, 3, 5}, items)
+
+	view.Remove(3)
+	for item := range set.RangeSeq(2, 5) {
+		t.Fatalf("item %d removed through the view was yielded", item)
+	}
+}
+
+func TestSetCollect(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("c", "a", "b")
+
+	collected := Collect(utils.StringComparator, set.BackwardSeq())
+	assert.Equal(t, []string{"a", "b", "c"}, collected.Values())
+
+	collected = Collect(utils.StringComparator, func(yield func(string) bool) {
+		_ = yield("a") && yield("a")
+	})
+	assert.Equal(t, 1, collected.Size())
+	assert.True(t, Collect(utils.StringComparator, NewWithComparator[string](utils.StringComparator).ValuesSeq()).Empty())
+}
@@ -5,13 +5,14 @@ import "github.com/mikekonan/gods-generic/tree/redblacktree"
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[V any] struct {
 	index    int
+	end      bool
 	iterator redblacktree.Iterator[V, struct{}]
-	tree     *redblacktree.Tree[V, struct{}]
+	size     func() int
 }
 
 // Iterator holding the iterator's state
 func (set *Set[V]) Iterator() Iterator[V] {
-	return Iterator[V]{index: -1, iterator: set.tree.Iterator(), tree: set.tree}
+	return Iterator[V]{index: -1, iterator: set.tree.Iterator(), size: set.tree.Size}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -19,20 +20,28 @@ func (set *Set[V]) Iterator() Iterator[V] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[V]) Next() bool {
-	if iterator.index < iterator.tree.Size() {
+	if iterator.iterator.Next() {
 		iterator.index++
+		return true
 	}
-	return iterator.iterator.Next()
+	if !iterator.end {
+		iterator.index++
+		iterator.end = true
+	}
+	return false
 }
 
 // Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[V]) Prev() bool {
-	if iterator.index >= 0 {
+	iterator.end = false
+	if iterator.iterator.Prev() {
 		iterator.index--
+		return true
 	}
-	return iterator.iterator.Prev()
+	iterator.index = -1
+	return false
 }
 
 // Value returns the current element's value.
@@ -51,13 +60,15 @@ func (iterator *Iterator[V]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[V]) Begin() {
 	iterator.index = -1
+	iterator.end = false
 	iterator.iterator.Begin()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[V]) End() {
-	iterator.index = iterator.tree.Size()
+	iterator.index = iterator.size()
+	iterator.end = true
 	iterator.iterator.End()
 }
 
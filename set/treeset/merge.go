@@ -0,0 +1,63 @@
+package treeset
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// MergeIterator walks several set iterators sharing a comparator as a single iterator over their union in order.
+type MergeIterator[V any] struct {
+	iterator *redblacktree.MergeIterator[V, struct{}]
+}
+
+// NewMergeIterator returns a stateful iterator merging the given set (or view) iterators, initialised before the first element.
+// Items present in several iterators are yielded once. Views are walked in order of the comparator regardless of their own order.
+// Panics if the iterators use different comparators.
+func NewMergeIterator[V any](iterators ...Iterator[V]) *MergeIterator[V] {
+	sources := make([]redblacktree.Iterator[V, struct{}], len(iterators))
+	for i, iterator := range iterators {
+		sources[i] = iterator.iterator
+	}
+	return &MergeIterator[V]{iterator: redblacktree.NewMergeIterator(redblacktree.FirstWins[V, struct{}], sources...)}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the merged order.
+// If Next() returns true, then next element's value can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[V]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the merged order.
+// If Prev() returns true, then previous element's value can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[V]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *MergeIterator[V]) Value() V {
+	return iterator.iterator.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *MergeIterator[V]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *MergeIterator[V]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element.
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[V]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element.
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[V]) Last() bool {
+	return iterator.iterator.Last()
+}
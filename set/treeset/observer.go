@@ -0,0 +1,25 @@
+package treeset
+
+import "github.com/mikekonan/gods-generic/tree/redblacktree"
+
+// Observe registers f to be called synchronously after every mutation of the set, in registration order,
+// including the ones made through views. The item is the Key of the event,
+// and adding a present item reports an Updated event carrying the new item. f must not mutate the set.
+// Returns a function that unregisters f.
+// UnionWith and IntersectWith report an event per item they add or remove.
+func (set *Set[V]) Observe(f func(event redblacktree.Event[V, struct{}])) (cancel func()) {
+	return set.tree.Observe(f)
+}
+
+// Subscribe returns a subscription receiving the events of the set on a channel buffered to hold buffer events.
+// Mutations never block on the subscription: events that do not fit in the buffer are dropped and counted.
+func (set *Set[V]) Subscribe(buffer int) *redblacktree.Subscription[V, struct{}] {
+	return set.tree.Subscribe(redblacktree.Range[V]{}, buffer)
+}
+
+// Subscribe returns a subscription receiving the events whose items lie within the view's range,
+// and every Cleared event, on a channel buffered to hold buffer events.
+// Mutations never block on the subscription: events that do not fit in the buffer are dropped and counted.
+func (view *View[V]) Subscribe(buffer int) *redblacktree.Subscription[V, struct{}] {
+	return view.tree.Subscribe(view.rng, buffer)
+}
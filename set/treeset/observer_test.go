@@ -0,0 +1,97 @@
+package treeset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetObserve(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	var events []redblacktree.Event[int, struct{}]
+	cancel := set.Observe(func(event redblacktree.Event[int, struct{}]) { events = append(events, event) })
+
+	set.Add(1)
+	set.Add(1)
+	assert.NoError(t, set.SubSet(0, 10).Add(2))
+	set.Remove(1, 42)
+	assert.Equal(t, []redblacktree.Event[int, struct{}]{
+		{Kind: redblacktree.Inserted, Key: 1},
+		{Kind: redblacktree.Updated, Key: 1},
+		{Kind: redblacktree.Inserted, Key: 2},
+		{Kind: redblacktree.Removed, Key: 1},
+	}, events)
+
+	cancel()
+	set.Add(3)
+	assert.Len(t, events, 4)
+}
+
+func TestSetObserveClear(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 2, 3)
+	var events []redblacktree.Event[int, struct{}]
+	set.Observe(func(event redblacktree.Event[int, struct{}]) { events = append(events, event) })
+
+	set.SubSet(2, 4).Clear()
+	assert.Equal(t, []redblacktree.Event[int, struct{}]{
+		{Kind: redblacktree.Removed, Key: 2},
+		{Kind: redblacktree.Removed, Key: 3},
+	}, events)
+
+	events = nil
+	set.Clear()
+	assert.Equal(t, []redblacktree.Event[int, struct{}]{{Kind: redblacktree.Cleared}}, events)
+}
+
+func TestSetSubscribeDropped(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	subscription := set.Subscribe(2)
+	defer subscription.Close()
+
+	set.Add(1, 2, 3)
+	set.Remove(3)
+	assert.Equal(t, uint64(2), subscription.Dropped())
+	assert.Equal(t, 1, (<-subscription.C).Key)
+	assert.Equal(t, 2, (<-subscription.C).Key)
+
+	// a drained buffer accepts events again
+	set.Add(4)
+	assert.Equal(t, 4, (<-subscription.C).Key)
+	assert.Equal(t, uint64(2), subscription.Dropped())
+}
+
+func TestSetViewSubscribe(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	subscription := set.SubSet(10, 20).Subscribe(10)
+
+	set.Add(5, 10, 20)
+	assert.NoError(t, set.TailSet(15, true).Add(19))
+	set.Remove(5, 10)
+	set.Clear()
+	subscription.Close()
+
+	var events []redblacktree.Event[int, struct{}]
+	for event := range subscription.C {
+		events = append(events, event)
+	}
+	assert.Equal(t, []redblacktree.Event[int, struct{}]{
+		{Kind: redblacktree.Inserted, Key: 10},
+		{Kind: redblacktree.Inserted, Key: 19},
+		{Kind: redblacktree.Removed, Key: 10},
+		{Kind: redblacktree.Cleared},
+	}, events)
+	assert.Equal(t, uint64(0), subscription.Dropped())
+}
+
+func TestSetDescendingViewSubscribe(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	subscription := set.HeadSet(10, false).DescendingSet().Subscribe(10)
+	defer subscription.Close()
+
+	set.Add(10, 3)
+	assert.Equal(t, redblacktree.Event[int, struct{}]{Kind: redblacktree.Inserted, Key: 3}, <-subscription.C)
+	assert.Empty(t, subscription.C)
+}
@@ -0,0 +1,49 @@
+package treeset
+
+import (
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Page returns up to limit items that follow the item encoded in afterToken when walking the set
+// in the given direction, together with the token of the next page.
+// An empty afterToken starts at the first item in that direction, an empty nextToken means there are no more items.
+// If the item of afterToken was removed in the meantime, the page resumes at its strict successor.
+// A non-positive limit returns all remaining items.
+// Items are encoded into tokens with the codec.
+func (set *Set[V]) Page(afterToken string, limit int, direction utils.Direction, codec utils.Codec[V]) (items []V, nextToken string, err error) {
+	var node *redblacktree.Node[V, struct{}]
+	switch {
+	case afterToken == "" && direction == utils.Descending:
+		node = set.tree.Right()
+	case afterToken == "":
+		node = set.tree.Left()
+	default:
+		item, err := utils.DecodeToken(codec, afterToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if direction == utils.Descending {
+			node, _ = set.tree.Lower(item)
+		} else {
+			node, _ = set.tree.Higher(item)
+		}
+	}
+	if node == nil {
+		return
+	}
+
+	it := set.tree.IteratorAt(node)
+	next := it.Next
+	if direction == utils.Descending {
+		next = it.Prev
+	}
+	for ok := true; ok; ok = next() {
+		if limit > 0 && len(items) == limit {
+			nextToken, err = utils.EncodeToken(codec, items[len(items)-1])
+			return
+		}
+		items = append(items, it.Key())
+	}
+	return
+}
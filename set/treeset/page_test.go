@@ -0,0 +1,97 @@
+package treeset
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPageAscending(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("a", "b", "c", "d", "e")
+
+	items, token, err := set.Page("", 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.NotEmpty(t, token)
+
+	items, token, err = set.Page(token, 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, items)
+
+	items, token, err = set.Page(token, 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e"}, items)
+	assert.Empty(t, token)
+}
+
+func TestSetPageDescending(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("a", "b", "c")
+
+	items, token, err := set.Page("", 2, utils.Descending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "b"}, items)
+
+	items, token, err = set.Page(token, 2, utils.Descending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, items)
+	assert.Empty(t, token)
+}
+
+func TestSetPageLastPageExactlyFull(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("a", "b")
+
+	items, token, err := set.Page("", 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.Empty(t, token)
+}
+
+func TestSetPageMalformedToken(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1)
+
+	_, _, err := set.Page("not base64!", 2, utils.Ascending, utils.IntCodec{})
+	assert.Error(t, err)
+
+	_, _, err = set.Page(base64.RawURLEncoding.EncodeToString([]byte("\x01x")), 2, utils.Ascending, utils.IntCodec{})
+	assert.Error(t, err)
+}
+
+func TestSetPageAnchorRemoved(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("a", "b", "c", "d")
+
+	_, token, err := set.Page("", 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	set.Remove("b")
+	items, _, err := set.Page(token, 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, items)
+
+	_, token, err = set.Page("", 2, utils.Descending, utils.StringCodec{})
+	assert.NoError(t, err)
+	set.Remove("c")
+	items, _, err = set.Page(token, 2, utils.Descending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, items)
+}
+
+func TestSetPageEmptyItem(t *testing.T) {
+	set := NewWithComparator[string](utils.StringComparator)
+	set.Add("", "a", "b")
+
+	items, token, err := set.Page("", 1, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, items)
+	assert.NotEmpty(t, token)
+
+	items, token, err = set.Page(token, 2, utils.Ascending, utils.StringCodec{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.Empty(t, token)
+}
@@ -46,6 +46,50 @@ func (set *Set[V]) RemoveIfFunc(item V, ifFunc func(V, V) bool) {
 	set.tree.RemoveIfFunc(item, ifFunc)
 }
 
+// PollFirst removes the minimum item from the set and returns it.
+// Second return parameter is true if set was not empty, otherwise false.
+func (set *Set[V]) PollFirst() (value V, found bool) {
+	if node, found := set.tree.RemoveMin(); found {
+		return node.Key, true
+	}
+	return
+}
+
+// PollLast removes the maximum item from the set and returns it.
+// Second return parameter is true if set was not empty, otherwise false.
+func (set *Set[V]) PollLast() (value V, found bool) {
+	if node, found := set.tree.RemoveMax(); found {
+		return node.Key, true
+	}
+	return
+}
+
+// PollFirstN removes up to n smallest items from the set and returns them in-order.
+func (set *Set[V]) PollFirstN(n int) []V {
+	var values []V
+	for ; n > 0; n-- {
+		node, found := set.tree.RemoveMin()
+		if !found {
+			break
+		}
+		values = append(values, node.Key)
+	}
+	return values
+}
+
+// PollLastN removes up to n largest items from the set and returns them in-reverse-order.
+func (set *Set[V]) PollLastN(n int) []V {
+	var values []V
+	for ; n > 0; n-- {
+		node, found := set.tree.RemoveMax()
+		if !found {
+			break
+		}
+		values = append(values, node.Key)
+	}
+	return values
+}
+
 // Contains checks weather items (one or more) are present in the set.
 // All items have to be present in the set for the method to return true.
 // Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
@@ -94,8 +138,8 @@ func (set *Set[V]) String() string {
 	return str
 }
 
-// First returns the minimum key and its value from the tree map.
-// Returns nil, nil if map is empty.
+// First returns the minimum item of the set.
+// Returns zero value if set is empty, use FirstOk to tell that apart from a zero item.
 func (m *Set[V]) First() (value V) {
 	if node := m.tree.Left(); node != nil {
 		return node.Key
@@ -104,8 +148,18 @@ func (m *Set[V]) First() (value V) {
 	return
 }
 
-// Last returns the minimum key and its value from the tree map.
-// Returns nil, nil if map is empty.
+// FirstOk returns the minimum item of the set.
+// Second return parameter is true if set is not empty, otherwise false.
+func (m *Set[V]) FirstOk() (value V, found bool) {
+	if node := m.tree.Left(); node != nil {
+		return node.Key, true
+	}
+
+	return
+}
+
+// Last returns the maximum item of the set.
+// Returns zero value if set is empty, use LastOk to tell that apart from a zero item.
 func (m *Set[V]) Last() (value V) {
 	if node := m.tree.Right(); node != nil {
 		return node.Key
@@ -113,3 +167,13 @@ func (m *Set[V]) Last() (value V) {
 
 	return
 }
+
+// LastOk returns the maximum item of the set.
+// Second return parameter is true if set is not empty, otherwise false.
+func (m *Set[V]) LastOk() (value V, found bool) {
+	if node := m.tree.Right(); node != nil {
+		return node.Key, true
+	}
+
+	return
+}
@@ -0,0 +1,88 @@
+package treeset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFirstLastOk(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(0)
+
+	value, found := set.FirstOk()
+	assert.Equal(t, 0, value)
+	assert.True(t, found)
+	value, found = set.LastOk()
+	assert.Equal(t, 0, value)
+	assert.True(t, found)
+
+	set.Add(-1, 1)
+	value, _ = set.FirstOk()
+	assert.Equal(t, -1, value)
+	value, _ = set.LastOk()
+	assert.Equal(t, 1, value)
+}
+
+func TestSetFirstLastOkEmpty(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+
+	value, found := set.FirstOk()
+	assert.Equal(t, 0, value)
+	assert.False(t, found)
+	value, found = set.LastOk()
+	assert.Equal(t, 0, value)
+	assert.False(t, found)
+}
+
+func TestSetPollFirst(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 0)
+
+	value, found := set.PollFirst()
+	assert.Equal(t, 0, value)
+	assert.True(t, found)
+	assert.Equal(t, []int{1}, set.Values())
+	value, _ = set.PollFirst()
+	assert.Equal(t, 1, value)
+	_, found = set.PollFirst()
+	assert.False(t, found)
+}
+
+func TestSetPollLast(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(0, 2)
+
+	value, found := set.PollLast()
+	assert.Equal(t, 2, value)
+	assert.True(t, found)
+	assert.Equal(t, []int{0}, set.Values())
+	value, _ = set.PollLast()
+	assert.Equal(t, 0, value)
+	_, found = set.PollLast()
+	assert.False(t, found)
+}
+
+func TestSetPollFirstN(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(0, 1, 2)
+
+	assert.Empty(t, set.PollFirstN(0))
+	assert.Empty(t, set.PollFirstN(-1))
+	assert.Equal(t, []int{0, 1}, set.PollFirstN(2))
+	assert.Equal(t, []int{2}, set.PollFirstN(5))
+	assert.True(t, set.Empty())
+	assert.Empty(t, set.PollFirstN(1))
+}
+
+func TestSetPollLastN(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(0, 1, 2)
+
+	assert.Empty(t, set.PollLastN(0))
+	assert.Equal(t, []int{2, 1}, set.PollLastN(2))
+	assert.Equal(t, []int{0}, set.PollLastN(5))
+	assert.True(t, set.Empty())
+	assert.Empty(t, set.PollLastN(1))
+}
@@ -0,0 +1,286 @@
+package treeset
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikekonan/gods-generic/tree/redblacktree"
+)
+
+// ErrOutOfRange is returned when an item outside of a view's range is added to the view.
+var ErrOutOfRange = errors.New("treeset: item out of view range")
+
+// View is a live view of a range of a set.
+// It is backed by the same tree as the set, so changes of the set are reflected in the view and vice versa.
+// Iteration follows the order of the view, while First and Last follow the order of the comparator.
+type View[V any] struct {
+	tree *redblacktree.Tree[V, struct{}]
+	rng  redblacktree.Range[V]
+}
+
+// HeadSet returns a view of the part of the set whose items are less than (or equal to, if inclusive is true) to.
+func (set *Set[V]) HeadSet(to V, inclusive bool) *View[V] {
+	return set.view().HeadSet(to, inclusive)
+}
+
+// TailSet returns a view of the part of the set whose items are greater than (or equal to, if inclusive is true) from.
+func (set *Set[V]) TailSet(from V, inclusive bool) *View[V] {
+	return set.view().TailSet(from, inclusive)
+}
+
+// SubSet returns a view of the part of the set whose items range from from, inclusive, to to, exclusive.
+func (set *Set[V]) SubSet(from, to V) *View[V] {
+	return set.view().SubSet(from, to)
+}
+
+// DescendingSet returns a view of the set in reverse order.
+func (set *Set[V]) DescendingSet() *View[V] {
+	return set.view().DescendingSet()
+}
+
+func (set *Set[V]) view() *View[V] {
+	return &View[V]{tree: set.tree}
+}
+
+// HeadSet returns a view of the part of this view whose items come before to in the view's order.
+// The item to itself is included if inclusive is true.
+func (view *View[V]) HeadSet(to V, inclusive bool) *View[V] {
+	bound := redblacktree.Exclusive(to)
+	if inclusive {
+		bound = redblacktree.Inclusive(to)
+	}
+	if view.rng.Descending {
+		return view.narrow(bound, redblacktree.Bound[V]{})
+	}
+	return view.narrow(redblacktree.Bound[V]{}, bound)
+}
+
+// TailSet returns a view of the part of this view whose items come after from in the view's order.
+// The item from itself is included if inclusive is true.
+func (view *View[V]) TailSet(from V, inclusive bool) *View[V] {
+	bound := redblacktree.Exclusive(from)
+	if inclusive {
+		bound = redblacktree.Inclusive(from)
+	}
+	if view.rng.Descending {
+		return view.narrow(redblacktree.Bound[V]{}, bound)
+	}
+	return view.narrow(bound, redblacktree.Bound[V]{})
+}
+
+// SubSet returns a view of the part of this view whose items range from from, inclusive, to to, exclusive,
+// both in the view's order.
+func (view *View[V]) SubSet(from, to V) *View[V] {
+	return view.TailSet(from, true).HeadSet(to, false)
+}
+
+// DescendingSet returns a view of this view in reverse order.
+func (view *View[V]) DescendingSet() *View[V] {
+	rng := view.rng
+	rng.Descending = !rng.Descending
+	return &View[V]{tree: view.tree, rng: rng}
+}
+
+func (view *View[V]) narrow(lower, upper redblacktree.Bound[V]) *View[V] {
+	return &View[V]{tree: view.tree, rng: view.tree.IntersectRange(view.rng, lower, upper)}
+}
+
+// Add adds the items (one or more) to the underlying set.
+// Returns ErrOutOfRange and leaves the set unchanged if any of the items lies outside of the view.
+func (view *View[V]) Add(items ...V) error {
+	for _, item := range items {
+		if !view.tree.InRange(view.rng, item) {
+			return ErrOutOfRange
+		}
+	}
+	for _, item := range items {
+		view.tree.Put(item, itemExists)
+	}
+	return nil
+}
+
+// Remove removes the items (one or more) from the underlying set, items outside of the view are ignored.
+func (view *View[V]) Remove(items ...V) {
+	for _, item := range items {
+		if view.tree.InRange(view.rng, item) {
+			view.tree.Remove(item)
+		}
+	}
+}
+
+// Contains checks weather items (one or more) are present in the view.
+// All items have to be present in the view for the method to return true.
+// Returns true if no arguments are passed at all, i.e. view is always superset of empty set.
+func (view *View[V]) Contains(items ...V) bool {
+	for _, item := range items {
+		if !view.tree.InRange(view.rng, item) {
+			return false
+		}
+		if _, contains := view.tree.Get(item); !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if view does not contain any elements.
+func (view *View[V]) Empty() bool {
+	return view.tree.RangeFirst(view.rng) == nil
+}
+
+// Size returns number of elements within the view.
+// Runs in time proportional to the number of elements within the view.
+func (view *View[V]) Size() int {
+	return view.tree.RangeSize(view.rng)
+}
+
+// Clear removes all items of the view from the underlying set.
+func (view *View[V]) Clear() {
+	for node := view.tree.RangeFirst(view.rng); node != nil; node = view.tree.RangeFirst(view.rng) {
+		view.tree.Remove(node.Key)
+	}
+}
+
+// Values returns all items of the view in the view's order.
+func (view *View[V]) Values() []V {
+	var values []V
+	it := view.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// ReversedValues returns all items of the view in the reverse of the view's order.
+func (view *View[V]) ReversedValues() []V {
+	var values []V
+	it := view.Iterator()
+	for it.End(); it.Prev(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// First returns the minimum item of the view.
+// Returns zero value if view is empty, use FirstOk to tell that apart from a zero item.
+func (view *View[V]) First() (value V) {
+	value, _ = view.FirstOk()
+	return
+}
+
+// FirstOk returns the minimum item of the view.
+// Second return parameter is true if view is not empty, otherwise false.
+func (view *View[V]) FirstOk() (value V, found bool) {
+	rng := view.rng
+	rng.Descending = false
+	if node := view.tree.RangeFirst(rng); node != nil {
+		return node.Key, true
+	}
+	return
+}
+
+// Last returns the maximum item of the view.
+// Returns zero value if view is empty, use LastOk to tell that apart from a zero item.
+func (view *View[V]) Last() (value V) {
+	value, _ = view.LastOk()
+	return
+}
+
+// LastOk returns the maximum item of the view.
+// Second return parameter is true if view is not empty, otherwise false.
+func (view *View[V]) LastOk() (value V, found bool) {
+	rng := view.rng
+	rng.Descending = false
+	if node := view.tree.RangeLast(rng); node != nil {
+		return node.Key, true
+	}
+	return
+}
+
+// Iterator returns a stateful iterator over the view whose values can be fetched by an index.
+func (view *View[V]) Iterator() Iterator[V] {
+	return Iterator[V]{index: -1, iterator: view.tree.RangeIterator(view.rng), size: view.Size}
+}
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (view *View[V]) Each(f func(index int, value V)) {
+	iterator := view.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// new set containing the values returned by the given function.
+func (view *View[V]) Map(f func(index int, value V) V) *Set[V] {
+	newSet := &Set[V]{tree: redblacktree.NewWithComparator[V, struct{}](view.tree.Comparator)}
+	iterator := view.Iterator()
+	for iterator.Next() {
+		newSet.Add(f(iterator.Index(), iterator.Value()))
+	}
+	return newSet
+}
+
+// Select returns a new set containing all elements for which the given function returns a true value.
+func (view *View[V]) Select(f func(index int, value V) bool) *Set[V] {
+	newSet := &Set[V]{tree: redblacktree.NewWithComparator[V, struct{}](view.tree.Comparator)}
+	iterator := view.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newSet.Add(iterator.Value())
+		}
+	}
+	return newSet
+}
+
+// Any passes each element of the view to the given function and
+// returns true if the function ever returns true for any element.
+func (view *View[V]) Any(f func(index int, value V) bool) bool {
+	iterator := view.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the view to the given function and
+// returns true if the function returns true for all elements.
+func (view *View[V]) All(f func(index int, value V) bool) bool {
+	iterator := view.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the view to the given function and returns
+// the first (index,value) for which the function is true or -1 and zero value otherwise
+// if no element matches the criteria.
+func (view *View[V]) Find(f func(index int, value V) bool) (i int, v V) {
+	iterator := view.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+
+	i = -1
+
+	return
+}
+
+// String returns a string representation of container
+func (view *View[V]) String() string {
+	str := "TreeSetView\n"
+	var items []string
+	for _, v := range view.Values() {
+		items = append(items, fmt.Sprintf("%v", v))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
@@ -0,0 +1,105 @@
+package treeset
+
+import (
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetViewBounds(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 2, 3, 4, 5)
+
+	assert.Equal(t, []int{1, 2}, set.HeadSet(3, false).Values())
+	assert.Equal(t, []int{1, 2, 3}, set.HeadSet(3, true).Values())
+	assert.Equal(t, []int{4, 5}, set.TailSet(3, false).Values())
+	assert.Equal(t, []int{3, 4, 5}, set.TailSet(3, true).Values())
+	assert.Equal(t, []int{2, 3}, set.SubSet(2, 4).Values())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, set.DescendingSet().Values())
+	assert.Equal(t, 2, set.SubSet(2, 4).Size())
+}
+
+func TestSetViewAddOutOfRange(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1)
+	view := set.SubSet(2, 4)
+
+	assert.ErrorIs(t, view.Add(4), ErrOutOfRange)
+	// nothing is added if any of the items is out of range
+	assert.ErrorIs(t, view.Add(2, 3, 0), ErrOutOfRange)
+	assert.Equal(t, []int{1}, set.Values())
+
+	assert.NoError(t, view.Add(2, 3))
+	assert.Equal(t, []int{1, 2, 3}, set.Values())
+
+	// removals outside of the view are ignored
+	view.Remove(1, 2)
+	assert.Equal(t, []int{1, 3}, set.Values())
+}
+
+func TestSetViewReflectsWrites(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	view := set.TailSet(2, true)
+	assert.True(t, view.Empty())
+
+	set.Add(1, 2, 3)
+	assert.Equal(t, []int{2, 3}, view.Values())
+	assert.True(t, view.Contains(2, 3))
+	assert.False(t, view.Contains(1))
+
+	set.Remove(2)
+	value, found := view.FirstOk()
+	assert.Equal(t, 3, value)
+	assert.True(t, found)
+
+	view.Clear()
+	assert.Equal(t, []int{1}, set.Values())
+}
+
+func TestSetViewOfDescendingSet(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 2, 3, 4, 5, 6)
+	descending := set.DescendingSet()
+
+	// bounds follow the order of the view
+	assert.Equal(t, []int{5, 4, 3}, descending.SubSet(5, 2).Values())
+	assert.Equal(t, []int{6, 5, 4}, descending.HeadSet(4, true).Values())
+	assert.Equal(t, []int{3, 2, 1}, descending.TailSet(4, false).Values())
+	assert.Equal(t, []int{3, 4, 5}, descending.SubSet(5, 2).DescendingSet().Values())
+
+	// First and Last follow the order of the comparator
+	assert.Equal(t, 3, descending.SubSet(5, 2).First())
+	assert.Equal(t, 5, descending.SubSet(5, 2).Last())
+
+	assert.ErrorIs(t, descending.SubSet(5, 2).Add(2), ErrOutOfRange)
+}
+
+func TestSetViewIterator(t *testing.T) {
+	set := NewWithComparator[int](utils.NumbersComparator[int])
+	set.Add(1, 2, 3, 4, 5)
+
+	tests := []struct {
+		view     *View[int]
+		backward []int
+	}{
+		{set.SubSet(2, 5), []int{4, 3, 2}},
+		{set.DescendingSet().SubSet(4, 1), []int{2, 3, 4}},
+		{set.HeadSet(0, false), nil},
+	}
+
+	for _, test := range tests {
+		var values []int
+		it := test.view.Iterator()
+		for it.End(); it.Prev(); {
+			values = append(values, it.Value())
+		}
+		assert.Equal(t, test.backward, values)
+
+		values = nil
+		for ok := it.Last(); ok; ok = it.Prev() {
+			values = append(values, it.Value())
+		}
+		assert.Equal(t, test.backward, values)
+	}
+}
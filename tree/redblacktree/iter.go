@@ -0,0 +1,69 @@
+//go:build go1.23
+
+package redblacktree
+
+import (
+	"iter"
+
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Collect instantiates a red-black tree with the custom comparator holding the key/value pairs of the sequence.
+func Collect[K any, V any](comparator utils.Comparator[K], seq iter.Seq2[K, V]) *Tree[K, V] {
+	tree := NewWithComparator[K, V](comparator)
+	for key, value := range seq {
+		tree.Put(key, value)
+	}
+	return tree
+}
+
+// AllSeq returns an iterator over all key/value pairs in-order.
+func (tree *Tree[K, V]) AllSeq() iter.Seq2[K, V] {
+	return tree.seq(tree.Iterator(), (*Iterator[K, V]).Next)
+}
+
+// BackwardSeq returns an iterator over all key/value pairs in-reverse-order.
+func (tree *Tree[K, V]) BackwardSeq() iter.Seq2[K, V] {
+	it := tree.Iterator()
+	it.End()
+	return tree.seq(it, (*Iterator[K, V]).Prev)
+}
+
+// RangeSeq returns an iterator over the key/value pairs whose keys range from lo, inclusive, to hi, exclusive, in-order.
+func (tree *Tree[K, V]) RangeSeq(lo, hi K) iter.Seq2[K, V] {
+	return tree.seq(tree.RangeIterator(Range[K]{Lower: Inclusive(lo), Upper: Exclusive(hi)}), (*Iterator[K, V]).Next)
+}
+
+// KeysSeq returns an iterator over all keys in-order.
+func (tree *Tree[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range tree.AllSeq() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over all values in-order based on the key.
+func (tree *Tree[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range tree.AllSeq() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// seq returns an iterator that starts every iteration from a copy of the given iterator and moves it with step.
+func (tree *Tree[K, V]) seq(start Iterator[K, V], step func(*Iterator[K, V]) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		it := start
+		for step(&it) {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
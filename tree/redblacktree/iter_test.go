@@ -0,0 +1,53 @@
+//go:build go1.23
+
+package redblacktree
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikekonan/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedBlackTreeSeq(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(4, "d")
+	tree.Put(2, "b")
+
+	var keys []int
+	var values []string
+	for key, value := range tree.AllSeq() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	assert.Equal(t, "1234", intSliceToString(keys))
+	assert.Equal(t, "abcd", strings.Join(values, ""))
+
+	keys = keys[:0]
+	for key := range tree.BackwardSeq() {
+		keys = append(keys, key)
+		if key == 2 {
+			break
+		}
+	}
+	assert.Equal(t, "432", intSliceToString(keys))
+
+	keys = keys[:0]
+	for key := range tree.RangeSeq(2, 4) {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, "23", intSliceToString(keys))
+
+	values = values[:0]
+	for value := range tree.ValuesSeq() {
+		values = append(values, value)
+	}
+	assert.Equal(t, "abcd", strings.Join(values, ""))
+
+	collected := Collect(utils.NumbersComparator[int], tree.BackwardSeq())
+	assert.Equal(t, tree.Keys(), collected.Keys())
+	assert.Equal(t, "abcd", strings.Join(collected.Values(), ""))
+}
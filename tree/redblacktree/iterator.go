@@ -5,6 +5,7 @@ type Iterator[K any, V any] struct {
 	tree     *Tree[K, V]
 	node     *Node[K, V]
 	position position
+	rng      *Range[K]
 }
 
 type position byte
@@ -28,6 +29,60 @@ func (tree *Tree[K, V]) IteratorAt(node *Node[K, V]) Iterator[K, V] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, V]) Next() bool {
+	if iterator.rng == nil {
+		return iterator.next()
+	}
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		iterator.node = iterator.tree.RangeFirst(*iterator.rng)
+	default:
+		if iterator.rng.Descending {
+			iterator.prev()
+		} else {
+			iterator.next()
+		}
+	}
+	return iterator.settle(end)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Prev() bool {
+	if iterator.rng == nil {
+		return iterator.prev()
+	}
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		iterator.node = iterator.tree.RangeLast(*iterator.rng)
+	default:
+		if iterator.rng.Descending {
+			iterator.next()
+		} else {
+			iterator.prev()
+		}
+	}
+	return iterator.settle(begin)
+}
+
+// settle keeps the iterator of a range on its current node if it lies within the range,
+// otherwise moves it to the given boundary position.
+func (iterator *Iterator[K, V]) settle(boundary position) bool {
+	if iterator.node == nil || !iterator.tree.InRange(*iterator.rng, iterator.node.Key) {
+		iterator.node = nil
+		iterator.position = boundary
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// next moves the iterator to the next element in key order, regardless of the range.
+func (iterator *Iterator[K, V]) next() bool {
 	if iterator.position == end {
 		goto end
 	}
@@ -66,10 +121,8 @@ between:
 	return true
 }
 
-// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
-// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
-// Modifies the state of the iterator.
-func (iterator *Iterator[K, V]) Prev() bool {
+// prev moves the iterator to the previous element in key order, regardless of the range.
+func (iterator *Iterator[K, V]) prev() bool {
 	if iterator.position == begin {
 		goto begin
 	}
@@ -0,0 +1,198 @@
+package redblacktree
+
+import (
+	"container/heap"
+
+	"github.com/mikekonan/gods-generic/utils"
+)
+
+// Resolver picks the value of a key that is present in several merged iterators.
+// Values are passed in the order of the iterators they come from.
+type Resolver[K any, V any] func(key K, values []V) V
+
+// FirstWins is a Resolver that keeps the value of the first iterator holding the key.
+func FirstWins[K any, V any](key K, values []V) V {
+	return values[0]
+}
+
+// LastWins is a Resolver that keeps the value of the last iterator holding the key.
+func LastWins[K any, V any](key K, values []V) V {
+	return values[len(values)-1]
+}
+
+// MergeIterator walks several iterators sharing a comparator as a single iterator in key order.
+// Keys present in several iterators are yielded once with the value picked by the resolver.
+// Iterators over ranges contribute the elements of their range, walked in key order regardless of the range's order.
+type MergeIterator[K any, V any] struct {
+	resolver Resolver[K, V]
+	heap     mergeHeap[K, V]
+	position position
+	key      K
+	value    V
+	merged   []int
+	values   []V
+}
+
+// NewMergeIterator returns a stateful iterator merging the given iterators, initialised before the first element.
+// Panics if the iterators use different comparators.
+func NewMergeIterator[K any, V any](resolver Resolver[K, V], iterators ...Iterator[K, V]) *MergeIterator[K, V] {
+	for i := 1; i < len(iterators); i++ {
+		if !utils.SameComparator(iterators[0].tree.Comparator, iterators[i].tree.Comparator) {
+			panic("redblacktree: merged iterators use different comparators")
+		}
+	}
+	sources := append([]Iterator[K, V](nil), iterators...)
+	return &MergeIterator[K, V]{resolver: resolver, heap: mergeHeap[K, V]{sources: sources}, position: begin}
+}
+
+// Next moves the iterator to the next key in the merged order and returns true if there was a next key.
+// If Next() returns true, then the key and its resolved value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) Next() bool {
+	switch {
+	case iterator.position == end:
+		return false
+	case iterator.position == begin:
+		iterator.heap.fill(nil, true)
+	case !iterator.heap.forward:
+		iterator.heap.fill(&iterator.key, true)
+	}
+	return iterator.pop(end)
+}
+
+// Prev moves the iterator to the previous key in the merged order and returns true if there was a previous key.
+// If Prev() returns true, then the key and its resolved value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) Prev() bool {
+	switch {
+	case iterator.position == begin:
+		return false
+	case iterator.position == end:
+		iterator.heap.fill(nil, false)
+	case iterator.heap.forward:
+		iterator.heap.fill(&iterator.key, false)
+	}
+	return iterator.pop(begin)
+}
+
+// Key returns the current key.
+// Does not modify the state of the iterator.
+func (iterator *MergeIterator[K, V]) Key() K {
+	return iterator.key
+}
+
+// Value returns the value resolved for the current key.
+// Does not modify the state of the iterator.
+func (iterator *MergeIterator[K, V]) Value() V {
+	return iterator.value
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *MergeIterator[K, V]) Begin() {
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *MergeIterator[K, V]) End() {
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element.
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element.
+// Modifies the state of the iterator.
+func (iterator *MergeIterator[K, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// pop takes all sources standing on the smallest (or largest, when walking backwards) key off the heap,
+// resolves their values and moves them on, or moves the iterator to the boundary if the heap is empty.
+func (iterator *MergeIterator[K, V]) pop(boundary position) bool {
+	h := &iterator.heap
+	if h.Len() == 0 {
+		iterator.position = boundary
+		return false
+	}
+
+	iterator.key = h.sources[h.items[0]].Key()
+	iterator.merged = iterator.merged[:0]
+	for h.Len() > 0 && h.comparator()(h.sources[h.items[0]].Key(), iterator.key) == 0 {
+		iterator.merged = append(iterator.merged, heap.Pop(h).(int))
+	}
+	// report values in the order of the iterators
+	for i := 1; i < len(iterator.merged); i++ {
+		for j := i; j > 0 && iterator.merged[j] < iterator.merged[j-1]; j-- {
+			iterator.merged[j], iterator.merged[j-1] = iterator.merged[j-1], iterator.merged[j]
+		}
+	}
+
+	iterator.values = iterator.values[:0]
+	for _, source := range iterator.merged {
+		iterator.values = append(iterator.values, h.sources[source].Value())
+		if h.sources[source].step(h.forward) {
+			heap.Push(h, source)
+		}
+	}
+	iterator.value = iterator.resolver(iterator.key, iterator.values)
+	iterator.position = between
+	return true
+}
+
+// mergeHeap orders the sources standing on an element by their keys,
+// smallest first when walking forward and largest first otherwise.
+type mergeHeap[K any, V any] struct {
+	sources []Iterator[K, V]
+	items   []int
+	forward bool
+}
+
+// fill positions every source after (or before) the key, or at its first (last) element if key is nil,
+// and rebuilds the heap for the given direction.
+func (h *mergeHeap[K, V]) fill(key *K, forward bool) {
+	h.forward = forward
+	h.items = h.items[:0]
+	for i := range h.sources {
+		if h.sources[i].seek(key, forward) {
+			h.items = append(h.items, i)
+		}
+	}
+	heap.Init(h)
+}
+
+func (h *mergeHeap[K, V]) comparator() utils.Comparator[K] {
+	return h.sources[0].tree.Comparator
+}
+
+func (h *mergeHeap[K, V]) Len() int {
+	return len(h.items)
+}
+
+func (h *mergeHeap[K, V]) Less(i, j int) bool {
+	compare := h.comparator()(h.sources[h.items[i]].Key(), h.sources[h.items[j]].Key())
+	if h.forward {
+		return compare < 0
+	}
+	return compare > 0
+}
+
+func (h *mergeHeap[K, V]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *mergeHeap[K, V]) Push(x interface{}) {
+	h.items = append(h.items, x.(int))
+}
+
+func (h *mergeHeap[K, V]) Pop() interface{} {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
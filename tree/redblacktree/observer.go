@@ -0,0 +1,136 @@
+package redblacktree
+
+import "sync/atomic"
+
+// EventKind tells which mutation an event describes.
+type EventKind int
+
+const (
+	// Inserted is fired after a new key was added.
+	Inserted EventKind = iota
+	// Updated is fired after the value of a present key was overwritten.
+	Updated
+	// Removed is fired after a key was removed.
+	Removed
+	// Cleared is fired after all keys were removed at once.
+	Cleared
+)
+
+// String returns the name of the event kind.
+func (kind EventKind) String() string {
+	switch kind {
+	case Inserted:
+		return "Inserted"
+	case Updated:
+		return "Updated"
+	case Removed:
+		return "Removed"
+	case Cleared:
+		return "Cleared"
+	default:
+		return "EventKind(?)"
+	}
+}
+
+// Event describes a single mutation of the tree.
+// OldValue is set for Updated and Removed events, Value for Inserted and Updated events.
+// Key is unset for Cleared events.
+type Event[K any, V any] struct {
+	Kind     EventKind
+	Key      K
+	OldValue V
+	Value    V
+}
+
+// observer is a registered callback. Observers are compared by pointer on cancellation.
+type observer[K any, V any] struct {
+	f func(Event[K, V])
+}
+
+// Observe registers f to be called synchronously after every mutation of the tree, in registration order.
+// f must not mutate the tree. Returns a function that unregisters f.
+func (tree *Tree[K, V]) Observe(f func(event Event[K, V])) (cancel func()) {
+	o := &observer[K, V]{f: f}
+	// observers are copied on write so that cancelling from within a callback is safe
+	tree.observers = append(tree.observers[:len(tree.observers):len(tree.observers)], o)
+	return func() {
+		observers := make([]*observer[K, V], 0, len(tree.observers))
+		for _, other := range tree.observers {
+			if other != o {
+				observers = append(observers, other)
+			}
+		}
+		if len(observers) == 0 {
+			observers = nil
+		}
+		tree.observers = observers
+	}
+}
+
+// Subscription delivers the events of a key range on a buffered channel.
+type Subscription[K any, V any] struct {
+	// C receives the events. It is closed by Close.
+	C       <-chan Event[K, V]
+	events  chan Event[K, V]
+	cancel  func()
+	dropped uint64
+}
+
+// Subscribe returns a subscription receiving the events whose keys lie within the range,
+// and every Cleared event, on a channel buffered to hold buffer events.
+// Mutations never block on the subscription: events that do not fit in the buffer are dropped and counted.
+func (tree *Tree[K, V]) Subscribe(r Range[K], buffer int) *Subscription[K, V] {
+	events := make(chan Event[K, V], buffer)
+	subscription := &Subscription[K, V]{C: events, events: events}
+	subscription.cancel = tree.Observe(func(event Event[K, V]) {
+		if event.Kind != Cleared && !tree.InRange(r, event.Key) {
+			return
+		}
+		select {
+		case events <- event:
+		default:
+			atomic.AddUint64(&subscription.dropped, 1)
+		}
+	})
+	return subscription
+}
+
+// Dropped returns the number of events dropped because the buffer was full.
+// Consumers seeing it grow should resynchronize from the tree itself.
+func (subscription *Subscription[K, V]) Dropped() uint64 {
+	return atomic.LoadUint64(&subscription.dropped)
+}
+
+// Close unregisters the subscription and closes its channel.
+// Like any mutation, it must not run concurrently with the tree's mutations.
+func (subscription *Subscription[K, V]) Close() {
+	if subscription.cancel != nil {
+		subscription.cancel()
+		subscription.cancel = nil
+		close(subscription.events)
+	}
+}
+
+func (tree *Tree[K, V]) notify(event Event[K, V]) {
+	for _, o := range tree.observers {
+		o.f(event)
+	}
+}
+
+func (tree *Tree[K, V]) notifyInserted(key K, value V) {
+	if tree.observers != nil {
+		tree.notify(Event[K, V]{Kind: Inserted, Key: key, Value: value})
+	}
+}
+
+func (tree *Tree[K, V]) notifyUpdated(key K, old, value V) {
+	if tree.observers != nil {
+		tree.notify(Event[K, V]{Kind: Updated, Key: key, OldValue: old, Value: value})
+	}
+}
+
+func (tree *Tree[K, V]) notifyRemoved(key K, old V) {
+	if tree.observers != nil {
+		tree.notify(Event[K, V]{Kind: Removed, Key: key, OldValue: old})
+	}
+}
@@ -0,0 +1,228 @@
+package redblacktree
+
+// Bound is one end of a key range. The zero value is unbounded.
+type Bound[K any] struct {
+	key       K
+	inclusive bool
+	bounded   bool
+}
+
+// Inclusive returns a bound that includes the key itself.
+func Inclusive[K any](key K) Bound[K] {
+	return Bound[K]{key: key, inclusive: true, bounded: true}
+}
+
+// Exclusive returns a bound that excludes the key itself.
+func Exclusive[K any](key K) Bound[K] {
+	return Bound[K]{key: key, bounded: true}
+}
+
+// Range is a contiguous range of keys between Lower and Upper.
+// Iterators over a descending range walk it from Upper to Lower.
+type Range[K any] struct {
+	Lower      Bound[K]
+	Upper      Bound[K]
+	Descending bool
+}
+
+// RangeIterator returns a stateful iterator over the key/value pairs within the range, in the range's order.
+// The iterator is backed by the tree and reflects its later modifications.
+func (tree *Tree[K, V]) RangeIterator(r Range[K]) Iterator[K, V] {
+	return Iterator[K, V]{tree: tree, node: nil, position: begin, rng: &r}
+}
+
+// InRange returns true if the key lies within the range.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) InRange(r Range[K], key K) bool {
+	return !tree.belowLower(r, key) && !tree.aboveUpper(r, key)
+}
+
+// IntersectRange narrows the range to the keys that also lie between lower and upper.
+// The order of the returned range is the order of r.
+func (tree *Tree[K, V]) IntersectRange(r Range[K], lower, upper Bound[K]) Range[K] {
+	if lower.bounded && (!r.Lower.bounded || tree.tighter(lower, r.Lower, 1)) {
+		r.Lower = lower
+	}
+	if upper.bounded && (!r.Upper.bounded || tree.tighter(upper, r.Upper, -1)) {
+		r.Upper = upper
+	}
+	return r
+}
+
+// RangeFirst returns the first node of the range in the range's order or nil if the range is empty.
+func (tree *Tree[K, V]) RangeFirst(r Range[K]) *Node[K, V] {
+	if r.Descending {
+		return tree.rangeRight(r)
+	}
+	return tree.rangeLeft(r)
+}
+
+// RangeLast returns the last node of the range in the range's order or nil if the range is empty.
+func (tree *Tree[K, V]) RangeLast(r Range[K]) *Node[K, V] {
+	if r.Descending {
+		return tree.rangeLeft(r)
+	}
+	return tree.rangeRight(r)
+}
+
+// RangeFloor finds the largest node within the range that is smaller than or equal to the given key.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) RangeFloor(r Range[K], key K) (floor *Node[K, V], found bool) {
+	if tree.aboveUpper(r, key) {
+		floor = tree.rangeRight(r)
+	} else {
+		floor, _ = tree.Floor(key)
+	}
+	if floor == nil || tree.belowLower(r, floor.Key) {
+		return nil, false
+	}
+	return floor, true
+}
+
+// RangeCeiling finds the smallest node within the range that is larger than or equal to the given key.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) RangeCeiling(r Range[K], key K) (ceiling *Node[K, V], found bool) {
+	if tree.belowLower(r, key) {
+		ceiling = tree.rangeLeft(r)
+	} else {
+		ceiling, _ = tree.Ceiling(key)
+	}
+	if ceiling == nil || tree.aboveUpper(r, ceiling.Key) {
+		return nil, false
+	}
+	return ceiling, true
+}
+
+// RangeSize returns number of nodes within the range.
+// Runs in time proportional to the number of nodes within the range.
+func (tree *Tree[K, V]) RangeSize(r Range[K]) int {
+	size := 0
+	it := tree.RangeIterator(r)
+	for it.Next() {
+		size++
+	}
+	return size
+}
+
+// rangeLeft returns the smallest node within the range or nil if the range is empty.
+func (tree *Tree[K, V]) rangeLeft(r Range[K]) *Node[K, V] {
+	var node *Node[K, V]
+	switch {
+	case !r.Lower.bounded:
+		node = tree.Left()
+	case r.Lower.inclusive:
+		node, _ = tree.Ceiling(r.Lower.key)
+	default:
+		node, _ = tree.Higher(r.Lower.key)
+	}
+	if node == nil || tree.aboveUpper(r, node.Key) {
+		return nil
+	}
+	return node
+}
+
+// rangeRight returns the largest node within the range or nil if the range is empty.
+func (tree *Tree[K, V]) rangeRight(r Range[K]) *Node[K, V] {
+	var node *Node[K, V]
+	switch {
+	case !r.Upper.bounded:
+		node = tree.Right()
+	case r.Upper.inclusive:
+		node, _ = tree.Floor(r.Upper.key)
+	default:
+		node, _ = tree.Lower(r.Upper.key)
+	}
+	if node == nil || tree.belowLower(r, node.Key) {
+		return nil
+	}
+	return node
+}
+
+func (tree *Tree[K, V]) belowLower(r Range[K], key K) bool {
+	if !r.Lower.bounded {
+		return false
+	}
+	compare := tree.Comparator(key, r.Lower.key)
+	return compare < 0 || compare == 0 && !r.Lower.inclusive
+}
+
+func (tree *Tree[K, V]) aboveUpper(r Range[K], key K) bool {
+	if !r.Upper.bounded {
+		return false
+	}
+	compare := tree.Comparator(key, r.Upper.key)
+	return compare > 0 || compare == 0 && !r.Upper.inclusive
+}
+
+// tighter returns true if bound a excludes more keys than bound b,
+// where sign is 1 for lower bounds and -1 for upper bounds.
+func (tree *Tree[K, V]) tighter(a, b Bound[K], sign int) bool {
+	compare := tree.Comparator(a.key, b.key) * sign
+	return compare > 0 || compare == 0 && !a.inclusive
+}
+
+// keyOrder returns the range of the iterator walked in key order, an unbounded range if it has none.
+func (iterator *Iterator[K, V]) keyOrder() Range[K] {
+	if iterator.rng == nil {
+		return Range[K]{}
+	}
+	r := *iterator.rng
+	r.Descending = false
+	return r
+}
+
+// seek puts the iterator, regardless of the order of its range, on its first element in key order that lies after key
+// (or before key, if forward is false), or on its very first (last) element if key is nil.
+// Returns false and moves the iterator out of its elements if there is no such element.
+func (iterator *Iterator[K, V]) seek(key *K, forward bool) bool {
+	tree, r := iterator.tree, iterator.keyOrder()
+	var node *Node[K, V]
+	switch {
+	case key == nil && forward:
+		node = tree.rangeLeft(r)
+	case key == nil:
+		node = tree.rangeRight(r)
+	case forward && tree.belowLower(r, *key):
+		node = tree.rangeLeft(r)
+	case forward:
+		if node, _ = tree.Higher(*key); node != nil && tree.aboveUpper(r, node.Key) {
+			node = nil
+		}
+	case tree.aboveUpper(r, *key):
+		node = tree.rangeRight(r)
+	default:
+		if node, _ = tree.Lower(*key); node != nil && tree.belowLower(r, node.Key) {
+			node = nil
+		}
+	}
+	iterator.node = node
+	return iterator.settleKeyOrder(forward)
+}
+
+// step moves the iterator to its next element in key order (or previous, if forward is false),
+// regardless of the order of its range.
+func (iterator *Iterator[K, V]) step(forward bool) bool {
+	if forward {
+		iterator.next()
+	} else {
+		iterator.prev()
+	}
+	return iterator.settleKeyOrder(forward)
+}
+
+func (iterator *Iterator[K, V]) settleKeyOrder(forward bool) bool {
+	if iterator.node != nil && (iterator.rng == nil || iterator.tree.InRange(*iterator.rng, iterator.node.Key)) {
+		iterator.position = between
+		return true
+	}
+	iterator.node = nil
+	iterator.position = begin
+	if forward {
+		iterator.position = end
+	}
+	return false
+}
@@ -102,6 +102,126 @@ func TestRedBlackTreeRemove(t *testing.T) {
 	assert.Equal(t, 0, len(tree.Values()))
 }
 
+func TestRedBlackTreeCompute(t *testing.T) {
+	tree := NewWithComparator[string, int](utils.StringComparator)
+	increment := func(old int, exists bool) (int, bool) {
+		return old + 1, true
+	}
+
+	value, present := tree.Compute("a", increment)
+	assert.Equal(t, 1, value)
+	assert.True(t, present)
+
+	value, present = tree.Compute("a", increment)
+	assert.Equal(t, 2, value)
+	assert.True(t, present)
+
+	value, present = tree.Compute("b", func(old int, exists bool) (int, bool) {
+		assert.False(t, exists)
+		return 0, false
+	})
+	assert.Equal(t, 0, value)
+	assert.False(t, present)
+	assert.Equal(t, 1, tree.Size())
+
+	value, present = tree.Compute("a", func(old int, exists bool) (int, bool) {
+		assert.True(t, exists)
+		assert.Equal(t, 2, old)
+		return 0, false
+	})
+	assert.Equal(t, 0, value)
+	assert.False(t, present)
+	assert.Equal(t, 0, tree.Size())
+}
+
+func TestRedBlackTreeMergeAndGetOrPut(t *testing.T) {
+	tree := NewWithComparator[string, int](utils.StringComparator)
+	sum := func(old, new int) int { return old + new }
+
+	assert.Equal(t, 2, tree.Merge("a", 2, sum))
+	assert.Equal(t, 5, tree.Merge("a", 3, sum))
+
+	calls := 0
+	value, loaded := tree.GetOrPut("a", func() int { calls++; return 10 })
+	assert.Equal(t, 5, value)
+	assert.True(t, loaded)
+	assert.Equal(t, 0, calls)
+
+	value, loaded = tree.GetOrPut("b", func() int { calls++; return 10 })
+	assert.Equal(t, 10, value)
+	assert.False(t, loaded)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, tree.Size())
+}
+
+func TestRedBlackTreePutIfAbsentReplaceAndSwap(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+
+	previous, loaded := tree.PutIfAbsent(1, "a")
+	assert.Equal(t, "", previous)
+	assert.False(t, loaded)
+
+	previous, loaded = tree.PutIfAbsent(1, "b")
+	assert.Equal(t, "a", previous)
+	assert.True(t, loaded)
+
+	previous, replaced := tree.Replace(2, "b")
+	assert.Equal(t, "", previous)
+	assert.False(t, replaced)
+	assert.Equal(t, 1, tree.Size())
+
+	previous, replaced = tree.Replace(1, "c")
+	assert.Equal(t, "a", previous)
+	assert.True(t, replaced)
+
+	previous, loaded = tree.Swap(1, "d")
+	assert.Equal(t, "c", previous)
+	assert.True(t, loaded)
+
+	previous, loaded = tree.Swap(2, "e")
+	assert.Equal(t, "", previous)
+	assert.False(t, loaded)
+	assert.Equal(t, "de", strings.Join(tree.Values(), ""))
+}
+
+func TestRedBlackTreeRemoveMinAndMax(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+
+	node, found := tree.RemoveMin()
+	assert.Nil(t, node)
+	assert.False(t, found)
+
+	node, found = tree.RemoveMax()
+	assert.Nil(t, node)
+	assert.False(t, found)
+
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+
+	node, found = tree.RemoveMin()
+	assert.Equal(t, 1, node.Key)
+	assert.Equal(t, "a", node.Value)
+	assert.True(t, found)
+
+	node, found = tree.RemoveMax()
+	assert.Equal(t, 7, node.Key)
+	assert.Equal(t, "g", node.Value)
+	assert.True(t, found)
+
+	assert.Equal(t, 5, tree.Size())
+	assert.Equal(t, "23456", intSliceToString(tree.Keys()))
+
+	for !tree.Empty() {
+		tree.RemoveMin()
+	}
+	assert.Nil(t, tree.Root)
+}
+
 func TestRedBlackTreeLeftAndRight(t *testing.T) {
 	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
 
@@ -159,6 +279,49 @@ func TestRedBlackTreeCeilingAndFloor(t *testing.T) {
 	assert.False(t, found)
 }
 
+func TestRedBlackTreeHigherAndLower(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+
+	node, found := tree.Higher(0)
+	assert.Nil(t, node)
+	assert.False(t, found)
+
+	node, found = tree.Lower(0)
+	assert.Nil(t, node)
+	assert.False(t, found)
+
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+
+	node, found = tree.Higher(3)
+	assert.Equal(t, 5, node.Key)
+	assert.True(t, found)
+
+	node, found = tree.Higher(4)
+	assert.Equal(t, 5, node.Key)
+	assert.True(t, found)
+
+	node, found = tree.Higher(7)
+	assert.Nil(t, node)
+	assert.False(t, found)
+
+	node, found = tree.Lower(5)
+	assert.Equal(t, 3, node.Key)
+	assert.True(t, found)
+
+	node, found = tree.Lower(4)
+	assert.Equal(t, 3, node.Key)
+	assert.True(t, found)
+
+	node, found = tree.Lower(1)
+	assert.Nil(t, node)
+	assert.False(t, found)
+}
+
 func TestRedBlackTreeIteratorNextOnEmpty(t *testing.T) {
 	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
 	it := tree.Iterator()
@@ -414,6 +577,250 @@ func TestRedBlackTreeIteratorLast(t *testing.T) {
 	assert.Equal(t, "c", it.Value())
 }
 
+func TestRedBlackTreeRangeIterator(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for i := 1; i <= 9; i++ {
+		tree.Put(i, strconv.Itoa(i))
+	}
+
+	tests := []struct {
+		rng      Range[int]
+		expected string
+	}{
+		{Range[int]{}, "123456789"},
+		{Range[int]{Lower: Inclusive(3), Upper: Exclusive(7)}, "3456"},
+		{Range[int]{Lower: Exclusive(3), Upper: Inclusive(7)}, "4567"},
+		{Range[int]{Lower: Inclusive(3), Upper: Exclusive(7), Descending: true}, "6543"},
+		{Range[int]{Upper: Exclusive(1)}, ""},
+		{Range[int]{Lower: Exclusive(9)}, ""},
+		{Range[int]{Lower: Inclusive(0), Upper: Inclusive(20)}, "123456789"},
+	}
+
+	for _, test := range tests {
+		var keys []int
+		it := tree.RangeIterator(test.rng)
+		for it.Next() {
+			keys = append(keys, it.Key())
+		}
+		assert.Equal(t, test.expected, intSliceToString(keys))
+		assert.Equal(t, len(test.expected), tree.RangeSize(test.rng))
+
+		keys = keys[:0]
+		for it.End(); it.Prev(); {
+			keys = append(keys, it.Key())
+		}
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+		assert.Equal(t, test.expected, intSliceToString(keys))
+	}
+}
+
+func TestRedBlackTreeRangeFloorAndCeiling(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for i := 1; i <= 9; i += 2 {
+		tree.Put(i, strconv.Itoa(i))
+	}
+	rng := tree.IntersectRange(Range[int]{}, Inclusive(3), Exclusive(7))
+	rng = tree.IntersectRange(rng, Inclusive(0), Inclusive(9))
+
+	assert.True(t, tree.InRange(rng, 3))
+	assert.False(t, tree.InRange(rng, 7))
+
+	node, found := tree.RangeFloor(rng, 9)
+	assert.Equal(t, 5, node.Key)
+	assert.True(t, found)
+
+	_, found = tree.RangeFloor(rng, 2)
+	assert.False(t, found)
+
+	node, found = tree.RangeCeiling(rng, 0)
+	assert.Equal(t, 3, node.Key)
+	assert.True(t, found)
+
+	_, found = tree.RangeCeiling(rng, 6)
+	assert.False(t, found)
+}
+
+func TestRedBlackTreeLoad(t *testing.T) {
+	for size := 0; size <= 64; size++ {
+		keys := make([]int, size)
+		values := make([]string, size)
+		for i := range keys {
+			keys[i] = i
+			values[i] = strconv.Itoa(i)
+		}
+
+		tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+		tree.Put(-1, "x")
+		tree.Load(keys, values)
+
+		assert.Equal(t, size, tree.Size())
+		assert.Equal(t, keys, append([]int{}, tree.Keys()...))
+		assertRedBlackProperties(t, tree)
+
+		for i := 0; i < size; i += 3 {
+			tree.Remove(i)
+			assertRedBlackProperties(t, tree)
+		}
+	}
+}
+
+func assertRedBlackProperties[K any, V any](t *testing.T, tree *Tree[K, V]) {
+	var blackHeight func(node *Node[K, V]) int
+	blackHeight = func(node *Node[K, V]) int {
+		if node == nil {
+			return 1
+		}
+		if node.color == red {
+			assert.Equal(t, black, nodeColor(node.Left), "red node %v has red child", node.Key)
+			assert.Equal(t, black, nodeColor(node.Right), "red node %v has red child", node.Key)
+		}
+		if node.Left != nil {
+			assert.Equal(t, node, node.Left.Parent)
+		}
+		if node.Right != nil {
+			assert.Equal(t, node, node.Right.Parent)
+		}
+		left, right := blackHeight(node.Left), blackHeight(node.Right)
+		assert.Equal(t, left, right, "unbalanced black height at %v", node.Key)
+		if node.color == black {
+			left++
+		}
+		return left
+	}
+	assert.Equal(t, black, nodeColor(tree.Root))
+	blackHeight(tree.Root)
+}
+
+func TestRedBlackTreeMergeIterator(t *testing.T) {
+	a := NewWithComparator[int, string](utils.NumbersComparator[int])
+	b := NewWithComparator[int, string](utils.NumbersComparator[int])
+	c := NewWithComparator[int, string](utils.NumbersComparator[int])
+	for _, key := range []int{1, 4, 7} {
+		a.Put(key, "a")
+	}
+	for _, key := range []int{2, 4, 8} {
+		b.Put(key, "b")
+	}
+	for _, key := range []int{3, 4, 5, 6, 9} {
+		c.Put(key, "c")
+	}
+
+	concat := func(key int, values []string) string { return strings.Join(values, "") }
+	it := NewMergeIterator(concat, a.Iterator(), b.Iterator(), c.RangeIterator(Range[int]{Upper: Inclusive(6), Descending: true}))
+
+	var keys []int
+	var values []string
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, "12345678", intSliceToString(keys))
+	assert.Equal(t, "a,b,c,abc,c,c,a,b", strings.Join(values, ","))
+
+	keys = keys[:0]
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	assert.Equal(t, "87654321", intSliceToString(keys))
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.True(t, it.Prev())
+	assert.Equal(t, 2, it.Key())
+	assert.True(t, it.Next())
+	assert.Equal(t, 3, it.Key())
+
+	assert.True(t, it.Last())
+	assert.Equal(t, 8, it.Key())
+	assert.Equal(t, "b", it.Value())
+
+	first := NewMergeIterator(FirstWins[int, string], a.Iterator(), b.Iterator())
+	last := NewMergeIterator(LastWins[int, string], a.Iterator(), b.Iterator())
+	for first.Next() && last.Next() {
+		if first.Key() == 4 {
+			assert.Equal(t, "a", first.Value())
+			assert.Equal(t, "b", last.Value())
+		}
+	}
+
+	assert.False(t, NewMergeIterator[int, string](concat).Next())
+	assert.Panics(t, func() {
+		reversed := NewWithComparator[int, string](func(a, b int) int { return b - a })
+		NewMergeIterator(concat, a.Iterator(), reversed.Iterator())
+	})
+}
+
+func TestRedBlackTreeObserve(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+	var events []Event[int, string]
+	cancel := tree.Observe(func(event Event[int, string]) {
+		events = append(events, event)
+	})
+
+	tree.Put(1, "a")
+	tree.Put(1, "b")
+	tree.PutIfFunc(1, "c", func(_, _ int) bool { return false })
+	tree.PutIfFunc(2, "x", func(_, _ int) bool { return true })
+	tree.Merge(2, "y", func(old, new string) string { return old + new })
+	tree.Compute(2, func(old string, exists bool) (string, bool) { return "", false })
+	tree.RemoveIfFunc(1, func(_, _ int) bool { return true })
+	tree.Remove(1)
+	tree.Load([]int{5, 6}, []string{"e", "f"})
+	tree.Clear()
+	assert.Equal(t, []Event[int, string]{
+		{Kind: Inserted, Key: 1, Value: "a"},
+		{Kind: Updated, Key: 1, OldValue: "a", Value: "b"},
+		{Kind: Inserted, Key: 2, Value: "x"},
+		{Kind: Updated, Key: 2, OldValue: "x", Value: "xy"},
+		{Kind: Removed, Key: 2, OldValue: "xy"},
+		{Kind: Removed, Key: 1, OldValue: "b"},
+		{Kind: Cleared},
+		{Kind: Inserted, Key: 5, Value: "e"},
+		{Kind: Inserted, Key: 6, Value: "f"},
+		{Kind: Cleared},
+	}, events)
+
+	// a node with two children is removed by unlinking its predecessor, the event still names the removed key
+	for i := 1; i <= 7; i++ {
+		tree.Put(i, strconv.Itoa(i))
+	}
+	events = nil
+	root := tree.Root
+	assert.True(t, root.Left != nil && root.Right != nil)
+	key := root.Key
+	tree.Remove(key)
+	assert.Equal(t, []Event[int, string]{{Kind: Removed, Key: key, OldValue: strconv.Itoa(key)}}, events)
+
+	cancel()
+	tree.Put(10, "z")
+	assert.Len(t, events, 1)
+	assert.Equal(t, "Updated", Updated.String())
+}
+
+func TestRedBlackTreeSubscribe(t *testing.T) {
+	tree := NewWithComparator[int, string](utils.NumbersComparator[int])
+	subscription := tree.Subscribe(Range[int]{Lower: Inclusive(10), Upper: Exclusive(20)}, 2)
+	tree.Put(5, "out")
+	tree.Put(10, "a")
+	tree.Put(19, "b")
+	tree.Put(15, "dropped")
+	tree.Put(20, "out")
+	assert.Equal(t, Event[int, string]{Kind: Inserted, Key: 10, Value: "a"}, <-subscription.C)
+	assert.Equal(t, Event[int, string]{Kind: Inserted, Key: 19, Value: "b"}, <-subscription.C)
+	assert.Equal(t, uint64(1), subscription.Dropped())
+
+	tree.Clear()
+	assert.Equal(t, Cleared, (<-subscription.C).Kind)
+	subscription.Close()
+	_, open := <-subscription.C
+	assert.False(t, open)
+	subscription.Close()
+	tree.Put(10, "a")
+}
+
 func TestRedBlackTreeSerialization(t *testing.T) {
 	tree := NewWithComparator[string, string](utils.StringComparator)
 	tree.Put("c", "3")
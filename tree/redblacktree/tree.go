@@ -2,6 +2,7 @@ package redblacktree
 
 import (
 	"fmt"
+	"math/bits"
 
 	"github.com/mikekonan/gods-generic/utils"
 )
@@ -17,6 +18,7 @@ type Tree[K any, V any] struct {
 	Root       *Node[K, V]
 	size       int
 	Comparator utils.Comparator[K]
+	observers  []*observer[K, V]
 }
 
 // Node is a single element within the tree
@@ -37,91 +39,129 @@ func NewWithComparator[K any, V any](comparator utils.Comparator[K]) *Tree[K, V]
 // Put inserts node into the tree.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[K, V]) Put(key K, value V) {
-	var insertedNode *Node[K, V]
-	if tree.Root == nil {
-		// Assert key is of comparator's type for initial tree
-		tree.Comparator(key, key)
-		tree.Root = &Node[K, V]{Key: key, Value: value, color: red}
-		insertedNode = tree.Root
-	} else {
-		node := tree.Root
-		loop := true
-		for loop {
-			compare := tree.Comparator(key, node.Key)
-			switch {
-			case compare == 0:
-				node.Key = key
-				node.Value = value
-				return
-			case compare < 0:
-				if node.Left == nil {
-					node.Left = &Node[K, V]{Key: key, Value: value, color: red}
-					insertedNode = node.Left
-					loop = false
-				} else {
-					node = node.Left
-				}
-			case compare > 0:
-				if node.Right == nil {
-					node.Right = &Node[K, V]{Key: key, Value: value, color: red}
-					insertedNode = node.Right
-					loop = false
-				} else {
-					node = node.Right
-				}
-			}
-		}
-		insertedNode.Parent = node
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		tree.insert(parent, compare, key, value)
+		return
 	}
-	tree.insertCase1(insertedNode)
-	tree.size++
+	old := node.Value
+	node.Key = key
+	node.Value = value
+	tree.notifyUpdated(key, old, value)
 }
 
 // PutIfFunc inserts node into the tree based on func(K,V) bool.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[K, V]) PutIfFunc(key K, value V, ifFunc func(K, K) bool) {
-	var insertedNode *Node[K, V]
-	if tree.Root == nil {
-		// Assert key is of comparator's type for initial tree
-		tree.Comparator(key, key)
-		tree.Root = &Node[K, V]{Key: key, Value: value, color: red}
-		insertedNode = tree.Root
-	} else {
-		node := tree.Root
-		loop := true
-		for loop {
-			compare := tree.Comparator(key, node.Key)
-			switch {
-			case compare == 0:
-				if !ifFunc(key, node.Key) {
-					return
-				}
-
-				node.Key = key
-				node.Value = value
-				return
-			case compare < 0:
-				if node.Left == nil {
-					node.Left = &Node[K, V]{Key: key, Value: value, color: red}
-					insertedNode = node.Left
-					loop = false
-				} else {
-					node = node.Left
-				}
-			case compare > 0:
-				if node.Right == nil {
-					node.Right = &Node[K, V]{Key: key, Value: value, color: red}
-					insertedNode = node.Right
-					loop = false
-				} else {
-					node = node.Right
-				}
-			}
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		tree.insert(parent, compare, key, value)
+		return
+	}
+	if !ifFunc(key, node.Key) {
+		return
+	}
+	old := node.Value
+	node.Key = key
+	node.Value = value
+	tree.notifyUpdated(key, old, value)
+}
+
+// PutIfAbsent inserts node into the tree only if the key is not present yet.
+// Returns the value already associated with the key and true if it was present, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) PutIfAbsent(key K, value V) (previous V, loaded bool) {
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		tree.insert(parent, compare, key, value)
+		return
+	}
+	return node.Value, true
+}
+
+// Replace updates the node of the tree only if the key is already present.
+// Returns the previous value and true if it was replaced, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Replace(key K, value V) (previous V, replaced bool) {
+	node := tree.lookup(key)
+	if node == nil {
+		return
+	}
+	previous = node.Value
+	node.Key = key
+	node.Value = value
+	tree.notifyUpdated(key, previous, value)
+	return previous, true
+}
+
+// Swap inserts node into the tree and returns the value it replaced.
+// Second return parameter is true if key was present before, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		tree.insert(parent, compare, key, value)
+		return
+	}
+	previous = node.Value
+	node.Key = key
+	node.Value = value
+	tree.notifyUpdated(key, previous, value)
+	return previous, true
+}
+
+// GetOrPut returns the value of the key, inserting the value returned by f if the key is not present.
+// Second return parameter is true if key was present before, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) GetOrPut(key K, f func() V) (value V, loaded bool) {
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		value = f()
+		tree.insert(parent, compare, key, value)
+		return value, false
+	}
+	return node.Value, true
+}
+
+// Compute computes a new value of the key from its current value in a single descent.
+// f receives the current value and whether the key is present, and returns the new value and whether to keep it.
+// If keep is false, the key is removed from the tree (or not inserted if it was absent).
+// Returns the resulting value and true if the key is present afterwards, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Compute(key K, f func(old V, exists bool) (newValue V, keep bool)) (value V, present bool) {
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		var zero V
+		if value, present = f(zero, false); present {
+			tree.insert(parent, compare, key, value)
+			return value, true
 		}
-		insertedNode.Parent = node
+		return zero, false
 	}
-	tree.insertCase1(insertedNode)
-	tree.size++
+	old := node.Value
+	if value, present = f(old, true); present {
+		node.Value = value
+		tree.notifyUpdated(node.Key, old, value)
+		return value, true
+	}
+	tree.removeNode(node)
+	var zero V
+	return zero, false
+}
+
+// Merge inserts the value if the key is not present, otherwise replaces the current value by f(current, value).
+// Returns the resulting value.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Merge(key K, value V, f func(old, new V) V) V {
+	node, parent, compare := tree.search(key)
+	if node == nil {
+		tree.insert(parent, compare, key, value)
+		return value
+	}
+	old := node.Value
+	node.Value = f(old, value)
+	tree.notifyUpdated(node.Key, old, node.Value)
+	return node.Value
 }
 
 // Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
@@ -139,39 +179,14 @@ func (tree *Tree[K, V]) Get(key K) (value V, found bool) {
 // Remove remove the node from the tree by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[K, V]) Remove(key K) {
-	var child *Node[K, V]
-	node := tree.lookup(key)
-	if node == nil {
-		return
+	if node := tree.lookup(key); node != nil {
+		tree.removeNode(node)
 	}
-	if node.Left != nil && node.Right != nil {
-		pred := node.Left.maximumNode()
-		node.Key = pred.Key
-		node.Value = pred.Value
-		node = pred
-	}
-	if node.Left == nil || node.Right == nil {
-		if node.Right == nil {
-			child = node.Left
-		} else {
-			child = node.Right
-		}
-		if node.color == black {
-			node.color = nodeColor(child)
-			tree.deleteCase1(node)
-		}
-		tree.replaceNode(node, child)
-		if node.Parent == nil && child != nil {
-			child.color = black
-		}
-	}
-	tree.size--
 }
 
 // RemoveIfFunc remove the node from the tree by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[K, V]) RemoveIfFunc(key K, ifFunc func(K, K) bool) {
-	var child *Node[K, V]
 	node := tree.lookup(key)
 	if node == nil {
 		return
@@ -181,28 +196,29 @@ func (tree *Tree[K, V]) RemoveIfFunc(key K, ifFunc func(K, K) bool) {
 		return
 	}
 
-	if node.Left != nil && node.Right != nil {
-		pred := node.Left.maximumNode()
-		node.Key = pred.Key
-		node.Value = pred.Value
-		node = pred
+	tree.removeNode(node)
+}
+
+// RemoveMin unlinks the left-most (min) node from the tree and returns it.
+// Second return parameter is true if tree was not empty, otherwise false.
+func (tree *Tree[K, V]) RemoveMin() (node *Node[K, V], found bool) {
+	if node = tree.Left(); node == nil {
+		return nil, false
 	}
-	if node.Left == nil || node.Right == nil {
-		if node.Right == nil {
-			child = node.Left
-		} else {
-			child = node.Right
-		}
-		if node.color == black {
-			node.color = nodeColor(child)
-			tree.deleteCase1(node)
-		}
-		tree.replaceNode(node, child)
-		if node.Parent == nil && child != nil {
-			child.color = black
-		}
+	// the left-most node has no left child, so removeNode unlinks the node itself
+	tree.removeNode(node)
+	return node, true
+}
+
+// RemoveMax unlinks the right-most (max) node from the tree and returns it.
+// Second return parameter is true if tree was not empty, otherwise false.
+func (tree *Tree[K, V]) RemoveMax() (node *Node[K, V], found bool) {
+	if node = tree.Right(); node == nil {
+		return nil, false
 	}
-	tree.size--
+	// the right-most node has no right child, so removeNode unlinks the node itself
+	tree.removeNode(node)
+	return node, true
 }
 
 // Empty returns true if tree does not contain any nodes
@@ -345,10 +361,65 @@ func (tree *Tree[K, V]) Ceiling(key K) (ceiling *Node[K, V], found bool) {
 	return nil, false
 }
 
+// Higher finds the smallest node that is strictly larger than the input key, return the node or nil if no such node is found.
+// Second return parameter is true if higher node was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Higher(key K) (higher *Node[K, V], found bool) {
+	node := tree.Root
+	for node != nil {
+		if tree.Comparator(key, node.Key) < 0 {
+			higher, found = node, true
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return higher, found
+}
+
+// Lower finds the largest node that is strictly smaller than the input key, return the node or nil if no such node is found.
+// Second return parameter is true if lower node was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Lower(key K) (lower *Node[K, V], found bool) {
+	node := tree.Root
+	for node != nil {
+		if tree.Comparator(key, node.Key) > 0 {
+			lower, found = node, true
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return lower, found
+}
+
 // Clear removes all nodes from the tree.
 func (tree *Tree[K, V]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	if tree.observers != nil {
+		tree.notify(Event[K, V]{Kind: Cleared})
+	}
+}
+
+// Load replaces all nodes of the tree by the given key-value pairs in O(n).
+// Observers get a Cleared event followed by an Inserted event per key.
+// Keys must be sorted in strictly ascending order according to the comparator and values must be of the same length.
+func (tree *Tree[K, V]) Load(keys []K, values []V) {
+	if len(keys) != len(values) {
+		panic("redblacktree: keys and values have different lengths")
+	}
+	// the tree is built perfectly balanced, so only the bottom level may be incomplete and is colored red
+	tree.Root = buildSorted(keys, values, nil, 0, bits.Len(uint(len(keys)))-1)
+	tree.size = len(keys)
+	if tree.observers != nil {
+		tree.notify(Event[K, V]{Kind: Cleared})
+		for i, key := range keys {
+			tree.notifyInserted(key, values[i])
+		}
+	}
 }
 
 // String returns a string representation of container
@@ -381,6 +452,71 @@ func (tree *Tree[K, V]) lookup(key K) *Node[K, V] {
 	return nil
 }
 
+// search looks the key up and returns its node, or nil together with the parent
+// the key would be attached to and the side (sign of compare) it would be attached on.
+func (tree *Tree[K, V]) search(key K) (node *Node[K, V], parent *Node[K, V], compare int) {
+	node = tree.Root
+	for node != nil {
+		compare = tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return node, parent, 0
+		case compare < 0:
+			parent, node = node, node.Left
+		case compare > 0:
+			parent, node = node, node.Right
+		}
+	}
+	return nil, parent, compare
+}
+
+// insert attaches a new node to the parent found by search and rebalances the tree.
+func (tree *Tree[K, V]) insert(parent *Node[K, V], compare int, key K, value V) *Node[K, V] {
+	node := &Node[K, V]{Key: key, Value: value, color: red, Parent: parent}
+	switch {
+	case parent == nil:
+		// Assert key is of comparator's type for initial tree
+		tree.Comparator(key, key)
+		tree.Root = node
+	case compare < 0:
+		parent.Left = node
+	default:
+		parent.Right = node
+	}
+	tree.insertCase1(node)
+	tree.size++
+	tree.notifyInserted(key, value)
+	return node
+}
+
+// removeNode unlinks the node from the tree and rebalances it.
+// A node with two children takes over the key and value of its in-order predecessor, which is unlinked instead.
+func (tree *Tree[K, V]) removeNode(node *Node[K, V]) {
+	key, value := node.Key, node.Value
+	var child *Node[K, V]
+	if node.Left != nil && node.Right != nil {
+		pred := node.Left.maximumNode()
+		node.Key = pred.Key
+		node.Value = pred.Value
+		node = pred
+	}
+	if node.Right == nil {
+		child = node.Left
+	} else {
+		child = node.Right
+	}
+	if node.color == black {
+		node.color = nodeColor(child)
+		tree.deleteCase1(node)
+	}
+	tree.replaceNode(node, child)
+	if node.Parent == nil && child != nil {
+		child.color = black
+	}
+	tree.size--
+	tree.notifyRemoved(key, value)
+}
+
 func (node *Node[K, V]) grandparent() *Node[K, V] {
 	if node != nil && node.Parent != nil {
 		return node.Parent.Parent
@@ -582,6 +718,20 @@ func (tree *Tree[K, V]) deleteCase6(node *Node[K, V]) {
 	}
 }
 
+func buildSorted[K any, V any](keys []K, values []V, parent *Node[K, V], depth, redDepth int) *Node[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	mid := len(keys) / 2
+	node := &Node[K, V]{Key: keys[mid], Value: values[mid], color: black, Parent: parent}
+	if depth == redDepth && depth > 0 {
+		node.color = red
+	}
+	node.Left = buildSorted(keys[:mid], values[:mid], node, depth+1, redDepth)
+	node.Right = buildSorted(keys[mid+1:], values[mid+1:], node, depth+1, redDepth)
+	return node
+}
+
 func nodeColor[K any, V any](node *Node[K, V]) color {
 	if node == nil {
 		return black
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Codec converts values to and from their binary representation.
+type Codec[T any] interface {
+	Encode(value T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// StringCodec encodes strings as their raw bytes.
+type StringCodec struct{}
+
+// Encode returns the bytes of the string.
+func (StringCodec) Encode(value string) ([]byte, error) {
+	return []byte(value), nil
+}
+
+// Decode returns the string made of the given bytes.
+func (StringCodec) Decode(data []byte) (string, error) {
+	return string(data), nil
+}
+
+// IntCodec encodes ints as their decimal representation.
+type IntCodec struct{}
+
+// Encode returns the decimal representation of the int.
+func (IntCodec) Encode(value int) ([]byte, error) {
+	return strconv.AppendInt(nil, int64(value), 10), nil
+}
+
+// Decode parses the decimal representation of an int.
+func (IntCodec) Decode(data []byte) (int, error) {
+	return strconv.Atoi(string(data))
+}
+
+// JSONCodec encodes values with encoding/json.
+type JSONCodec[T any] struct{}
+
+// Encode returns the JSON encoding of the value.
+func (JSONCodec[T]) Encode(value T) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// Decode parses the JSON encoding of a value.
+func (JSONCodec[T]) Decode(data []byte) (value T, err error) {
+	err = json.Unmarshal(data, &value)
+	return
+}
@@ -0,0 +1,26 @@
+package utils
+
+import "fmt"
+
+// CollisionPolicy decides what happens when a mapping function produces the same key more than once.
+type CollisionPolicy int
+
+const (
+	// KeepLast keeps the value produced last for the key.
+	KeepLast CollisionPolicy = iota
+	// KeepFirst keeps the value produced first for the key.
+	KeepFirst
+	// FailOnCollision reports colliding keys as a CollisionError.
+	FailOnCollision
+)
+
+// CollisionError is returned when a mapping function produces the same keys more than once under FailOnCollision.
+type CollisionError[K any] struct {
+	// Keys holds every colliding key once, in-order.
+	Keys []K
+}
+
+// Error returns the error message listing the colliding keys.
+func (err *CollisionError[K]) Error() string {
+	return fmt.Sprintf("colliding keys: %v", err.Keys)
+}
@@ -1,6 +1,9 @@
 package utils
 
-import "time"
+import (
+	"reflect"
+	"time"
+)
 
 type Comparator[K any] func(a, b K) int
 
@@ -48,3 +51,13 @@ func TimeComparator(a, b time.Time) int {
 		return 0
 	}
 }
+
+// SameComparator reports whether both comparators refer to the same function.
+//
+// Only the code pointer is compared, so closures created by the same function literal are reported
+// as the same comparator even when they capture different state, for example
+// treeset.Comparator(ascending) and treeset.Comparator(descending). Operations that must not mix orders
+// should also check that both comparators agree on the items they compare.
+func SameComparator[K any](a, b Comparator[K]) bool {
+	return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
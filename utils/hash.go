@@ -0,0 +1,33 @@
+package utils
+
+// HashSeed is the initial value to fold hashes into with HashCombine.
+const HashSeed uint64 = 14695981039346656037
+
+// HashCombine mixes the hash of the next element into an order-dependent running hash.
+func HashCombine(hash, next uint64) uint64 {
+	const prime = 1099511628211
+	return (hash ^ mix(next)) * prime
+}
+
+// StringHash returns the FNV-1a hash of a string.
+func StringHash(s string) uint64 {
+	hash := HashSeed
+	for i := 0; i < len(s); i++ {
+		hash ^= uint64(s[i])
+		hash *= 1099511628211
+	}
+	return hash
+}
+
+// IntegerHash returns a hash of an integer with its bits spread over the whole result.
+func IntegerHash[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64](value T) uint64 {
+	return mix(uint64(value))
+}
+
+// mix spreads the bits of a value over the result, so that close values hash far apart.
+func mix(value uint64) uint64 {
+	value ^= value >> 33
+	value *= 0xff51afd7ed558ccd
+	value ^= value >> 33
+	return value
+}
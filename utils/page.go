@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Direction is the order in which an ordered container is walked.
+type Direction int
+
+const (
+	// Ascending walks the container from the smallest to the largest key.
+	Ascending Direction = iota
+	// Descending walks the container from the largest to the smallest key.
+	Descending
+)
+
+// tokenVersion prefixes every encoded token, so that no token is empty even if its key encodes to no bytes
+// and the empty token stays reserved for the start and the end of a listing.
+const tokenVersion byte = 1
+
+// EncodeToken encodes the key into an opaque continuation token using the codec.
+// The token is never empty.
+func EncodeToken[K any](codec Codec[K], key K) (string, error) {
+	data, err := codec.Encode(key)
+	if err != nil {
+		return "", fmt.Errorf("encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(append([]byte{tokenVersion}, data...)), nil
+}
+
+// DecodeToken decodes the key from a continuation token produced by EncodeToken.
+func DecodeToken[K any](codec Codec[K], token string) (key K, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return key, fmt.Errorf("decode page token: %w", err)
+	}
+	if len(data) == 0 || data[0] != tokenVersion {
+		return key, errors.New("decode page token: unknown token version")
+	}
+	if key, err = codec.Decode(data[1:]); err != nil {
+		return key, fmt.Errorf("decode page token: %w", err)
+	}
+	return key, nil
+}